@@ -0,0 +1,205 @@
+// Package bytesinput provides a byte size input, accepting values such as "512MB" or "1.5 GiB".
+package bytesinput
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/input"
+	"github.com/tj/go-tea/internal/number"
+)
+
+// Byte sizes.
+const (
+	B   int64 = 1
+	KiB       = 1024 * B
+	MiB       = 1024 * KiB
+	GiB       = 1024 * MiB
+	TiB       = 1024 * GiB
+	PiB       = 1024 * TiB
+)
+
+// units is a map of unit names to sizes. Single letter units are binary,
+// while SI units such as "kb" are decimal.
+var units = map[string]int64{
+	"":    B,
+	"b":   B,
+	"k":   KiB,
+	"kb":  1e3,
+	"kib": KiB,
+	"m":   MiB,
+	"mb":  1e6,
+	"mib": MiB,
+	"g":   GiB,
+	"gb":  1e9,
+	"gib": GiB,
+	"t":   TiB,
+	"tb":  1e12,
+	"tib": TiB,
+	"p":   PiB,
+	"pb":  1e15,
+	"pib": PiB,
+}
+
+// Model is the byte size input model.
+type Model struct {
+	// Input is the text input, which may be used to set the prompt or
+	// placeholder. Its Filter is replaced to allow only the characters
+	// of a byte size.
+	Input input.Model
+
+	// Min is the minimum number of bytes, enforced when HasMin is set.
+	Min int64
+
+	// HasMin enables the minimum, which may be zero.
+	HasMin bool
+
+	// Max is the maximum number of bytes, enforced when HasMax is set.
+	Max int64
+
+	// HasMax enables the maximum, which may be zero.
+	HasMax bool
+
+	// Step is the number of bytes added or subtracted by Up and Down. Defaults to MiB.
+	Step int64
+
+	// Err is the current parse or bounds error, which is rendered beneath the input.
+	Err error
+}
+
+// Value returns the number of bytes, the minimum when empty, or 0 when invalid.
+func (m *Model) Value() int64 {
+	n := m.number()
+	return number.AsInt(n.Value())
+}
+
+// SetValue sets the number of bytes.
+func (m *Model) SetValue(v int64) {
+	n := m.number()
+	n.SetValue(value(v))
+	m.Input, m.Err = n.Input, n.Err
+}
+
+// Valid validates the current value, returning true if it is valid.
+// Unlike while editing, an empty value is invalid.
+func (m *Model) Valid() bool {
+	n := m.number()
+	ok := n.Valid()
+	m.Err = n.Err
+	return ok
+}
+
+// Update function.
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	n, cmd := number.Update(msg, m.number())
+	m.Input, m.Err = n.Input, n.Err
+	return m, cmd
+}
+
+// View function.
+func View(m Model) string {
+	return number.View(m.number())
+}
+
+// Parse a byte size such as "512", "10 KB" or "1.5GiB".
+func Parse(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+
+	i := strings.IndexFunc(s, unicode.IsLetter)
+	if i < 0 {
+		i = len(s)
+	}
+
+	unit, ok := units[strings.ToLower(s[i:])]
+	if !ok {
+		return 0, fmt.Errorf("invalid unit %q", s[i:])
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(s[:i]), 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return 0, errors.New("invalid size")
+	}
+
+	n = math.Round(n * float64(unit))
+	if math.Abs(n) >= math.MaxInt64 {
+		return 0, errors.New("size too large")
+	}
+
+	return int64(n), nil
+}
+
+// Format a byte size using the largest binary unit which represents it
+// exactly with at most two decimal places, such as "1.5 GiB".
+func Format(n int64) string {
+	names := []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"}
+
+	for i := len(names) - 1; i > 0; i-- {
+		unit := math.Pow(1024, float64(i))
+		if math.Abs(float64(n)) < unit {
+			continue
+		}
+
+		v := float64(n) / unit
+		if math.Round(v*100) == v*100 {
+			return strconv.FormatFloat(v, 'f', -1, 64) + " " + names[i]
+		}
+	}
+
+	return strconv.FormatInt(n, 10) + " B"
+}
+
+// number returns the numeric input model.
+func (m *Model) number() number.Model {
+	step := m.Step
+	if step == 0 {
+		step = MiB
+	}
+
+	return number.Model{
+		Type:  size,
+		Input: m.Input,
+		Min:   bound(m.Min, m.HasMin),
+		Max:   bound(m.Max, m.HasMax),
+		Step:  value(step),
+		Err:   m.Err,
+	}
+}
+
+// size is the byte size type.
+var size = number.Type{
+	Parse:  parse,
+	Filter: filter,
+	Zero:   value(0),
+}
+
+// value returns n bytes as a value.
+func value(n int64) number.Value {
+	return number.Int(n, Format)
+}
+
+// bound returns n bytes as a bound, or nil when unset.
+func bound(n int64, ok bool) number.Value {
+	if !ok {
+		return nil
+	}
+	return value(n)
+}
+
+// parse a byte size.
+func parse(s string) (number.Value, error) {
+	n, err := Parse(s)
+	if err != nil {
+		return nil, errors.New("must be a size such as 512MB")
+	}
+	return value(n), nil
+}
+
+// filter returns true for the characters of a byte size.
+func filter(r rune) bool {
+	return r >= '0' && r <= '9' || strings.ContainsRune(". bBkKmMgGtTpPiI", r)
+}
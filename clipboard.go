@@ -0,0 +1,109 @@
+package tea
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+)
+
+// ClipboardMsg is sent to your program's Update() function
+// with the contents of the clipboard, in response to ReadClipboard.
+type ClipboardMsg string
+
+// setClipboardMsg is the internal message for setting the clipboard.
+type setClipboardMsg string
+
+// readClipboardMsg is the internal message for requesting the clipboard.
+type readClipboardMsg struct{}
+
+// osc52 is the prefix of clipboard escape sequences and responses.
+const osc52 = "\033]52;"
+
+// maxOSC is the maximum size of a buffered clipboard response.
+const maxOSC = 1 << 20
+
+// SetClipboard returns a command which copies s to the system clipboard
+// using the OSC 52 escape sequence, which works over SSH in terminals
+// supporting it.
+func SetClipboard(s string) Cmd {
+	return func(ctx context.Context) Msg {
+		return setClipboardMsg(s)
+	}
+}
+
+// ReadClipboard is a command which requests the contents of the system
+// clipboard using the OSC 52 escape sequence, which are sent as a ClipboardMsg.
+// Many terminals disallow reading the clipboard, in which case no msg is sent.
+func ReadClipboard(ctx context.Context) Msg {
+	return readClipboardMsg{}
+}
+
+// parseInput returns the msgs for bytes read from the terminal. Clipboard
+// responses may span several reads, so an incomplete response is buffered
+// in osc and returned, to be passed with the next read.
+func parseInput(b, osc []byte) (msgs []Msg, rest []byte, err error) {
+	if len(osc) > 0 || bytes.HasPrefix(b, []byte(osc52)) {
+		osc = append(osc, b...)
+
+		end, size := oscEnd(osc)
+		if end < 0 {
+			if len(osc) > maxOSC {
+				return nil, nil, nil
+			}
+			return nil, osc, nil
+		}
+
+		if s, ok := parseClipboard(osc[:end]); ok {
+			msgs = append(msgs, ClipboardMsg(s))
+		}
+
+		b = osc[end+size:]
+		if len(b) == 0 {
+			return msgs, nil, nil
+		}
+	}
+
+	k, err := parseKey(b)
+	if err != nil {
+		return msgs, nil, err
+	}
+
+	return append(msgs, k), nil, nil
+}
+
+// oscEnd returns the index and size of the terminator of an
+// operating system command, either BEL or ST, or -1.
+func oscEnd(b []byte) (int, int) {
+	for i, c := range b {
+		switch {
+		case c == '\a':
+			return i, 1
+		case c == '\033' && i+1 < len(b) && b[i+1] == '\\':
+			return i, 2
+		}
+	}
+	return -1, 0
+}
+
+// parseClipboard returns the contents of a clipboard response, which is of
+// the form "ESC ] 52 ; selection ; base64" without the terminator.
+func parseClipboard(b []byte) (string, bool) {
+	b = bytes.TrimPrefix(b, []byte(osc52))
+
+	i := bytes.IndexByte(b, ';')
+	if i < 0 {
+		return "", false
+	}
+
+	s, err := base64.StdEncoding.DecodeString(string(b[i+1:]))
+	if err != nil {
+		return "", false
+	}
+
+	return string(s), true
+}
+
+// clipboardSequence returns the escape sequence setting the clipboard to s.
+func clipboardSequence(s string) string {
+	return osc52 + "c;" + base64.StdEncoding.EncodeToString([]byte(s)) + "\a"
+}
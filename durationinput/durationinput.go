@@ -0,0 +1,128 @@
+// Package durationinput provides a duration input, accepting values such as "1m30s".
+package durationinput
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/input"
+	"github.com/tj/go-tea/internal/number"
+)
+
+// Model is the duration input model.
+type Model struct {
+	// Input is the text input, which may be used to set the prompt or
+	// placeholder. Its Filter is replaced to allow only the characters
+	// of a duration.
+	Input input.Model
+
+	// Min is the minimum value, enforced when HasMin is set.
+	Min time.Duration
+
+	// HasMin enables the minimum, which may be zero.
+	HasMin bool
+
+	// Max is the maximum value, enforced when HasMax is set.
+	Max time.Duration
+
+	// HasMax enables the maximum, which may be zero.
+	HasMax bool
+
+	// Step is the amount added or subtracted by Up and Down. Defaults to one second.
+	Step time.Duration
+
+	// Err is the current parse or bounds error, which is rendered beneath the input.
+	Err error
+}
+
+// Value returns the value, the minimum when empty, or 0 when invalid.
+func (m *Model) Value() time.Duration {
+	n := m.number()
+	return time.Duration(number.AsInt(n.Value()))
+}
+
+// SetValue sets the value.
+func (m *Model) SetValue(d time.Duration) {
+	n := m.number()
+	n.SetValue(value(d))
+	m.Input, m.Err = n.Input, n.Err
+}
+
+// Valid validates the current value, returning true if it is valid.
+// Unlike while editing, an empty value is invalid.
+func (m *Model) Valid() bool {
+	n := m.number()
+	ok := n.Valid()
+	m.Err = n.Err
+	return ok
+}
+
+// Update function.
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	n, cmd := number.Update(msg, m.number())
+	m.Input, m.Err = n.Input, n.Err
+	return m, cmd
+}
+
+// View function.
+func View(m Model) string {
+	return number.View(m.number())
+}
+
+// number returns the numeric input model.
+func (m *Model) number() number.Model {
+	step := m.Step
+	if step == 0 {
+		step = time.Second
+	}
+
+	return number.Model{
+		Type:  duration,
+		Input: m.Input,
+		Min:   bound(m.Min, m.HasMin),
+		Max:   bound(m.Max, m.HasMax),
+		Step:  value(step),
+		Err:   m.Err,
+	}
+}
+
+// duration is the duration type.
+var duration = number.Type{
+	Parse:  parse,
+	Filter: filter,
+	Zero:   value(0),
+}
+
+// value returns d as a value.
+func value(d time.Duration) number.Value {
+	return number.Int(int64(d), format)
+}
+
+// bound returns d as a bound, or nil when unset.
+func bound(d time.Duration, ok bool) number.Value {
+	if !ok {
+		return nil
+	}
+	return value(d)
+}
+
+// parse a duration.
+func parse(s string) (number.Value, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return nil, errors.New("must be a duration such as 1m30s")
+	}
+	return value(d), nil
+}
+
+// format a duration.
+func format(n int64) string {
+	return time.Duration(n).String()
+}
+
+// filter returns true for the characters of a duration.
+func filter(r rune) bool {
+	return r >= '0' && r <= '9' || strings.ContainsRune(".-+nuµmsh", r)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/tj/go-tea"
 	"github.com/tj/go-tea/input"
+	"github.com/tj/go-tea/theme"
 	"github.com/tj/go-terminput"
 )
 
@@ -29,18 +30,19 @@ func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
 // update function.
 func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.Cmd) {
 	m := model.(Model)
-	m.Confirm = input.Update(msg, m.Confirm)
+
+	confirm, cmd := input.Update(msg, m.Confirm)
+	m.Confirm = confirm
 
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
+	case tea.KeyMsg:
 		switch msg.Key() {
 		case terminput.KeyEnter:
 			if m.Confirm.Value == m.ProjectID {
 				m.Confirmed = true
 				return m, tea.Quit
 			}
-			bell()
-			return m, nil
+			return m, tea.Bell
 		case terminput.KeyEscape:
 			return m, tea.Quit
 		case terminput.KeyRune:
@@ -51,7 +53,7 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 		}
 	}
 
-	return m, nil
+	return m, cmd
 }
 
 // view function.
@@ -67,7 +69,7 @@ func view(ctx context.Context, model tea.Model) string {
 	if m.Confirmed {
 		fmt.Fprintf(w, "  Deleted %q.\n\n", m.ProjectID)
 	} else if !strings.HasPrefix(m.ProjectID, m.Confirm.Value) {
-		fmt.Fprintf(w, "  Enter %q to confirm deletion: %s\n", m.ProjectID, red(input.View(m.Confirm)))
+		fmt.Fprintf(w, "  Enter %q to confirm deletion: %s\n", m.ProjectID, theme.Current().Error.Render(input.View(m.Confirm)))
 	} else {
 		fmt.Fprintf(w, "  Enter %q to confirm deletion: %s\n", m.ProjectID, input.View(m.Confirm))
 	}
@@ -75,16 +77,6 @@ func view(ctx context.Context, model tea.Model) string {
 	return w.String()
 }
 
-// red string.
-func red(s string) string {
-	return fmt.Sprintf("\033[31m%s\033[0m", s)
-}
-
-// bell sound.
-func bell() {
-	fmt.Printf("\a")
-}
-
 func main() {
 	program := tea.NewProgram(initialize, update, view)
 	err := program.Start(context.Background())
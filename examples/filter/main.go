@@ -0,0 +1,114 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/option"
+	"github.com/tj/go-tea/theme"
+	"github.com/tj/go-terminput"
+)
+
+// Model struct.
+type Model struct {
+	Option   option.Model
+	Selected bool
+}
+
+// initialize function.
+func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
+	return Model{
+		Option: option.Model{
+			Filterable: true,
+			Height:     8,
+			Wrap:       true,
+			Selected:   1,
+			Items: []option.Item{
+				{Label: "United States", Header: true},
+				{Label: "us-east-1"},
+				{Label: "us-east-2", Disabled: true, Hint: "quota exceeded"},
+				{Label: "us-west-1"},
+				{Label: "us-west-2"},
+				{Label: "Africa", Header: true},
+				{Label: "af-south-1"},
+				{Label: "Asia Pacific", Header: true},
+				{Label: "ap-east-1", Disabled: true, Hint: "opt-in required"},
+				{Label: "ap-south-1"},
+				{Label: "ap-northeast-1"},
+				{Label: "ap-northeast-2"},
+				{Label: "ap-northeast-3"},
+				{Label: "ap-southeast-1"},
+				{Label: "ap-southeast-2"},
+				{Label: "Canada", Header: true},
+				{Label: "ca-central-1"},
+				{Label: "Europe", Header: true},
+				{Label: "eu-central-1"},
+				{Label: "eu-west-1"},
+				{Label: "eu-west-2"},
+				{Label: "eu-west-3"},
+				{Label: "eu-south-1"},
+				{Label: "eu-north-1"},
+				{Label: "Middle East", Header: true},
+				{Label: "me-south-1"},
+				{Label: "South America", Header: true},
+				{Label: "sa-east-1"},
+			},
+		},
+	}, nil
+}
+
+// update function.
+func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.Cmd) {
+	m := model.(Model)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.Key() {
+		case terminput.KeyEnter:
+			if !m.Option.HasValue() {
+				return m, tea.Bell
+			}
+			m.Selected = true
+			return m, tea.Quit
+		case terminput.KeyEscape:
+			if !m.Option.Filtering() {
+				return m, tea.Quit
+			}
+		}
+	}
+
+	option, cmd := option.Update(msg, m.Option)
+	m.Option = option
+	return m, cmd
+}
+
+// view function.
+func view(ctx context.Context, model tea.Model) string {
+	w := new(bytes.Buffer)
+	m := model.(Model)
+
+	// padding
+	fmt.Fprintf(w, "\n")
+	defer fmt.Fprintf(w, "\n")
+
+	if m.Selected {
+		fmt.Fprintf(w, "  Deploying to %s\n", m.Option.Value())
+		return w.String()
+	}
+
+	fmt.Fprintf(w, "  Select a region %s\n\n", theme.Current().Muted.Render("(type to filter)"))
+	fmt.Fprintf(w, "%s", option.View(m.Option))
+
+	return w.String()
+}
+
+func main() {
+	program := tea.NewProgram(initialize, update, view)
+	err := program.Start(context.Background())
+	if err != nil {
+		log.Fatalf("error: %s\n", err)
+	}
+}
@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/input"
+	"github.com/tj/go-terminput"
+)
+
+// Model struct.
+type Model struct {
+	Input  input.Model
+	Output []string
+}
+
+// newInitialize returns an initialize function using the given history.
+func newInitialize(history *input.History) tea.Init {
+	return func(ctx context.Context) (tea.Model, tea.Cmd) {
+		return Model{
+			Input: input.Model{
+				Prompt:      "  > ",
+				Placeholder: "Type a command, Up for history, Ctrl-R to search",
+				History:     history,
+			},
+		}, nil
+	}
+}
+
+// update function.
+func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.Cmd) {
+	m := model.(Model)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.Key() {
+		case terminput.KeyEnter:
+			value := m.Input.Value
+			if strings.TrimSpace(value) == "" {
+				return m, nil
+			}
+
+			if err := m.Input.History.Add(value); err != nil {
+				return m, func(ctx context.Context) tea.Msg {
+					return err
+				}
+			}
+
+			if value == "exit" {
+				return m, tea.Quit
+			}
+
+			m.Output = append(m.Output, value)
+			m.Input.Value = ""
+			return m, nil
+		case terminput.KeyEscape:
+			return m, tea.Quit
+		}
+	}
+
+	input, cmd := input.Update(msg, m.Input)
+	m.Input = input
+	return m, cmd
+}
+
+// view function.
+func view(ctx context.Context, model tea.Model) string {
+	w := new(bytes.Buffer)
+	m := model.(Model)
+
+	// padding
+	fmt.Fprintf(w, "\n")
+	defer fmt.Fprintf(w, "\n")
+
+	for _, s := range m.Output {
+		fmt.Fprintf(w, "  %s\n", s)
+	}
+
+	fmt.Fprintf(w, "%s\n", input.View(m.Input))
+
+	return w.String()
+}
+
+func main() {
+	history, err := input.NewHistory(filepath.Join(os.TempDir(), "tea-history"))
+	if err != nil {
+		log.Fatalf("error: %s\n", err)
+	}
+
+	program := tea.NewProgram(newInitialize(history), update, view)
+	err = program.Start(context.Background())
+	if err != nil {
+		log.Fatalf("error: %s\n", err)
+	}
+}
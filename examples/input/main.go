@@ -20,7 +20,10 @@ type Model struct {
 // initialize function.
 func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
 	return Model{
-		Input:   input.Model{},
+		Input: input.Model{
+			Placeholder: "Tobi",
+			Width:       20,
+		},
 		Editing: true,
 	}, nil
 }
@@ -28,10 +31,12 @@ func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
 // update function.
 func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.Cmd) {
 	m := model.(Model)
-	m.Input = input.Update(msg, m.Input)
+
+	input, cmd := input.Update(msg, m.Input)
+	m.Input = input
 
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
+	case tea.KeyMsg:
 		switch msg.Key() {
 		case terminput.KeyEnter:
 			m.Editing = false
@@ -46,7 +51,7 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 		}
 	}
 
-	return m, nil
+	return m, cmd
 }
 
 // view function.
@@ -68,10 +73,6 @@ func view(ctx context.Context, model tea.Model) string {
 	return w.String()
 }
 
-func bell() {
-	fmt.Printf("\a")
-}
-
 func main() {
 	program := tea.NewProgram(initialize, update, view)
 	err := program.Start(context.Background())
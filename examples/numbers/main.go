@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/bytesinput"
+	"github.com/tj/go-tea/durationinput"
+	"github.com/tj/go-tea/input"
+	"github.com/tj/go-tea/intinput"
+	"github.com/tj/go-terminput"
+)
+
+// Model struct.
+type Model struct {
+	Replicas intinput.Model
+	Memory   bytesinput.Model
+	Timeout  durationinput.Model
+	Focus    int
+	Done     bool
+}
+
+// initialize function.
+func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
+	m := Model{
+		Replicas: intinput.Model{
+			Input:  input.Model{Prompt: "  Replicas: ", Suffix: " instances"},
+			Min:    1,
+			HasMin: true,
+			Max:    10,
+			HasMax: true,
+		},
+		Memory: bytesinput.Model{
+			Input:  input.Model{Prompt: "  Memory:   "},
+			Min:    128 * bytesinput.MiB,
+			HasMin: true,
+			Max:    4 * bytesinput.GiB,
+			HasMax: true,
+			Step:   128 * bytesinput.MiB,
+		},
+		Timeout: durationinput.Model{
+			Input:  input.Model{Prompt: "  Timeout:  "},
+			HasMin: true,
+			Max:    5 * time.Minute,
+			HasMax: true,
+			Step:   15 * time.Second,
+		},
+	}
+
+	m.Replicas.SetValue(3)
+	m.Memory.SetValue(512 * bytesinput.MiB)
+	m.Timeout.SetValue(30 * time.Second)
+	return m, nil
+}
+
+// update function.
+func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.Cmd) {
+	m := model.(Model)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.Key() {
+		case terminput.KeyTab:
+			m.Focus = (m.Focus + 1) % 3
+			return m, nil
+		case terminput.KeyEnter:
+			if !m.Replicas.Valid() || !m.Memory.Valid() || !m.Timeout.Valid() {
+				return m, tea.Bell
+			}
+			m.Done = true
+			return m, tea.Quit
+		case terminput.KeyEscape:
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+	switch m.Focus {
+	case 0:
+		m.Replicas, cmd = intinput.Update(msg, m.Replicas)
+	case 1:
+		m.Memory, cmd = bytesinput.Update(msg, m.Memory)
+	case 2:
+		m.Timeout, cmd = durationinput.Update(msg, m.Timeout)
+	}
+
+	return m, cmd
+}
+
+// view function.
+func view(ctx context.Context, model tea.Model) string {
+	w := new(bytes.Buffer)
+	m := model.(Model)
+
+	// padding
+	fmt.Fprintf(w, "\n")
+	defer fmt.Fprintf(w, "\n")
+
+	if m.Done {
+		fmt.Fprintf(w, "  Deploying %d replicas with %d bytes of memory and a %s timeout\n", m.Replicas.Value(), m.Memory.Value(), m.Timeout.Value())
+		return w.String()
+	}
+
+	fmt.Fprintf(w, "%s\n", intinput.View(m.Replicas))
+	fmt.Fprintf(w, "%s\n", bytesinput.View(m.Memory))
+	fmt.Fprintf(w, "%s\n", durationinput.View(m.Timeout))
+
+	return w.String()
+}
+
+func main() {
+	program := tea.NewProgram(initialize, update, view)
+	err := program.Start(context.Background())
+	if err != nil {
+		log.Fatalf("error: %s\n", err)
+	}
+}
@@ -21,6 +21,8 @@ type Model struct {
 func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
 	return Model{
 		Option: option.Model{
+			Hotkeys:        option.MnemonicHotkeys,
+			SubmitOnHotkey: true,
 			Options: []string{
 				"Tobi",
 				"Loki",
@@ -37,14 +39,13 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 	m := model.(Model)
 
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
+	case tea.KeyMsg:
 		switch msg.Key() {
 		case terminput.KeyEnter:
 			if m.Selected {
 				return m, tea.Quit
 			}
-			m.Selected = true
-			return m, nil
+			return updateOption(msg, m)
 		case terminput.KeyEscape:
 			return m, tea.Quit
 		case terminput.KeyRune:
@@ -52,22 +53,27 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 			case 'q':
 				return m, tea.Quit
 			default:
-				if !m.Selected {
-					m.Option = option.Update(msg, m.Option)
-				}
-				return m, nil
+				return updateOption(msg, m)
 			}
 		default:
-			if !m.Selected {
-				m.Option = option.Update(msg, m.Option)
-			}
-			return m, nil
+			return updateOption(msg, m)
 		}
 	}
 
 	return m, nil
 }
 
+// updateOption delegates msgs to the option until a selection is made.
+func updateOption(msg tea.Msg, m Model) (tea.Model, tea.Cmd) {
+	if m.Selected {
+		return m, nil
+	}
+	option, cmd := option.Update(msg, m.Option)
+	m.Option = option
+	m.Selected = option.Submitted
+	return m, cmd
+}
+
 // view function.
 func view(ctx context.Context, model tea.Model) string {
 	w := new(bytes.Buffer)
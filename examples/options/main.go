@@ -21,6 +21,8 @@ type Model struct {
 func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
 	return Model{
 		Options: options.Model{
+			Min: 1,
+			Max: 3,
 			Options: []string{
 				"Tobi",
 				"Loki",
@@ -37,14 +39,13 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 	m := model.(Model)
 
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
+	case tea.KeyMsg:
 		switch msg.Key() {
 		case terminput.KeyEnter:
 			if m.Selected {
 				return m, tea.Quit
 			}
-			m.Selected = true
-			return m, nil
+			return updateOptions(msg, m)
 		case terminput.KeyEscape:
 			return m, tea.Quit
 		case terminput.KeyRune:
@@ -52,22 +53,27 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 			case 'q':
 				return m, tea.Quit
 			default:
-				if !m.Selected {
-					m.Options = options.Update(msg, m.Options)
-				}
-				return m, nil
+				return updateOptions(msg, m)
 			}
 		default:
-			if !m.Selected {
-				m.Options = options.Update(msg, m.Options)
-			}
-			return m, nil
+			return updateOptions(msg, m)
 		}
 	}
 
 	return m, nil
 }
 
+// updateOptions delegates msgs to the options until a selection is made.
+func updateOptions(msg tea.Msg, m Model) (tea.Model, tea.Cmd) {
+	if m.Selected {
+		return m, nil
+	}
+	options, cmd := options.Update(msg, m.Options)
+	m.Options = options
+	m.Selected = options.Submitted
+	return m, cmd
+}
+
 // view function.
 func view(ctx context.Context, model tea.Model) string {
 	w := new(bytes.Buffer)
@@ -84,7 +90,7 @@ func view(ctx context.Context, model tea.Model) string {
 			fmt.Fprintf(w, "  - %s\n", o)
 		}
 	} else {
-		fmt.Fprintf(w, "  Choose your favorite pet:\n\n")
+		fmt.Fprintf(w, "  Choose up to 3 of your favorite pets:\n\n")
 		fmt.Fprintf(w, "%s", options.View(m.Options))
 	}
 
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/input"
+	"github.com/tj/go-terminput"
+)
+
+// Model struct.
+type Model struct {
+	Token input.Model
+	Saved bool
+}
+
+// initialize function.
+func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
+	return Model{
+		Token: input.Model{
+			Placeholder: "sk_live_...",
+			EchoMode:    input.EchoPassword,
+			MaskRune:    '•',
+			Width:       32,
+		},
+	}, nil
+}
+
+// update function.
+func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.Cmd) {
+	m := model.(Model)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.Key() {
+		case terminput.KeyEnter:
+			m.Saved = true
+			return m, tea.Quit
+		case terminput.KeyEscape:
+			return m, tea.Quit
+		case terminput.KeyTab:
+			// toggle visibility
+			if m.Token.EchoMode == input.EchoPassword {
+				m.Token.EchoMode = input.EchoNormal
+			} else {
+				m.Token.EchoMode = input.EchoPassword
+			}
+			return m, nil
+		}
+	}
+
+	token, cmd := input.Update(msg, m.Token)
+	m.Token = token
+	return m, cmd
+}
+
+// view function.
+func view(ctx context.Context, model tea.Model) string {
+	w := new(bytes.Buffer)
+	m := model.(Model)
+
+	// padding
+	fmt.Fprintf(w, "\n")
+	defer fmt.Fprintf(w, "\n")
+
+	if m.Saved {
+		fmt.Fprintf(w, "  Saved API token (%d characters).\n", len([]rune(m.Token.Value)))
+		return w.String()
+	}
+
+	fmt.Fprintf(w, "  API token: %s\n\n", input.View(m.Token))
+	fmt.Fprintf(w, "  [tab] Show/Hide [enter] Save [esc] Cancel\n")
+
+	return w.String()
+}
+
+func main() {
+	program := tea.NewProgram(initialize, update, view)
+	err := program.Start(context.Background())
+	if err != nil {
+		log.Fatalf("error: %s\n", err)
+	}
+}
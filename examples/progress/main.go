@@ -12,6 +12,7 @@ import (
 
 	"github.com/tj/go-tea"
 	"github.com/tj/go-tea/progress"
+	"github.com/tj/go-tea/theme"
 	"github.com/tj/go-terminput"
 )
 
@@ -77,7 +78,7 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 		m.Previous = msg
 		m.Durations = append(m.Durations, msg.Duration)
 		return m, request(m.URL)
-	case *terminput.KeyboardInput:
+	case tea.KeyMsg:
 		// pressed esc or q
 		if msg.Key() == terminput.KeyEscape || msg.Rune() == 'q' {
 			return m, tea.Quit
@@ -94,7 +95,7 @@ func view(ctx context.Context, model tea.Model) string {
 	var w strings.Builder
 	fmt.Fprintf(&w, "\n")
 	if m.Progress.Percent < 1 {
-		fmt.Fprintf(&w, "  Benchmarking \033[1m%s\033[m\n\n", m.URL)
+		fmt.Fprintf(&w, "  Benchmarking %s\n\n", theme.Current().Accent.Render(m.URL))
 		fmt.Fprintf(&w, "  %s\n\n", progress.View(m.Progress))
 		fmt.Fprintf(&w, "   Request: %d of %d\n", m.Iteration, m.MaxIterations)
 		fmt.Fprintf(&w, "    Status: %d\n", m.Previous.StatusCode)
@@ -7,22 +7,40 @@ import (
 	"log"
 
 	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/sequence"
 	"github.com/tj/go-tea/viewport"
 	"github.com/tj/go-terminput"
 )
 
+// GotoTop msg.
+type GotoTop struct{}
+
+// GotoBottom msg.
+type GotoBottom struct{}
+
+// posts is the number of posts listed.
+const posts = 100
+
 // Model struct.
 type Model struct {
 	List viewport.Model
+	Keys sequence.Model
 }
 
 // initialize function.
 func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
 	return Model{
 		List: viewport.Model{
-			Height:       45,
-			ScrollBy:     5,
-			ScrollHeight: 100,
+			Content:  viewList(posts),
+			Height:   45,
+			Width:    30,
+			ScrollBy: 5,
+		},
+		Keys: sequence.Model{
+			Bindings: []sequence.Binding{
+				{Keys: "g g", Help: "Top", Action: GotoTop{}},
+				{Keys: "G", Help: "Bottom", Action: GotoBottom{}},
+			},
 		},
 	}, nil
 }
@@ -30,13 +48,30 @@ func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
 // update function.
 func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.Cmd) {
 	m := model.(Model)
-	m.List = viewport.Update(msg, m.List)
+
+	// key sequences
+	keys, cmd := sequence.Update(msg, m.Keys)
+	m.Keys = keys
+	if cmd != nil {
+		return m, cmd
+	}
 
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
+	case tea.WindowSizeMsg:
+		m.List.Height = msg.Height - 5
+		return m, nil
+	case GotoTop:
+		m.List.GotoTop()
+		return m, nil
+	case GotoBottom:
+		m.List.GotoBottom()
+		return m, nil
+	case tea.KeyMsg:
 		switch msg.Key() {
 		case terminput.KeyEscape:
-			return m, tea.Quit
+			if _, _, ok := m.List.Selection(); !ok {
+				return m, tea.Quit
+			}
 		case terminput.KeyRune:
 			switch r := msg.Rune(); r {
 			case 'q':
@@ -45,7 +80,10 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 		}
 	}
 
-	return m, nil
+	list, cmd := viewport.Update(msg, m.List)
+	m.List = list
+
+	return m, cmd
 }
 
 // view function.
@@ -58,7 +96,10 @@ func view(ctx context.Context, model tea.Model) string {
 	defer fmt.Fprintf(w, "\n")
 
 	// list
-	fmt.Fprintf(w, viewport.View(m.List, viewList(100)))
+	fmt.Fprintf(w, viewport.View(m.List))
+
+	// help
+	fmt.Fprintf(w, "\n  [g g] Top [G] Bottom [v] Select [y] Copy [q] Quit %s\n", sequence.View(m.Keys))
 
 	return w.String()
 }
@@ -66,7 +107,7 @@ func view(ctx context.Context, model tea.Model) string {
 // viewList returns a generated list of n items.
 func viewList(n int) (s string) {
 	for i := 0; i < n; i++ {
-		s += fmt.Sprintf("  %d) Some blog post\n", i)
+		s += fmt.Sprintf("  %d) Some blog post with a rather long title\n", i)
 	}
 	return
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/tj/go-tea"
 	"github.com/tj/go-tea/spinner"
+	"github.com/tj/go-tea/theme"
 	"github.com/tj/go-terminput"
 )
 
@@ -27,7 +28,7 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 	m := model.(Model)
 
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
+	case tea.KeyMsg:
 		// pressed esc or q
 		if msg.Key() == terminput.KeyEscape || msg.Rune() == 'q' {
 			return m, tea.Quit
@@ -42,7 +43,7 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 // view function.
 func view(ctx context.Context, model tea.Model) string {
 	m := model.(Model)
-	return fmt.Sprintf("\n  Deploying %s\n\n  [q] Quit", green(spinner.View(m.Spinner)))
+	return fmt.Sprintf("\n  Deploying %s\n\n  [q] Quit", theme.Current().Success.Render(spinner.View(m.Spinner)))
 }
 
 func main() {
@@ -52,8 +53,3 @@ func main() {
 		log.Fatalf("error: %s\r\n", err)
 	}
 }
-
-// green string.
-func green(s string) string {
-	return fmt.Sprintf("\033[32m%s\033[0m", s)
-}
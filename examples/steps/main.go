@@ -36,7 +36,7 @@ func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
 func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.Cmd) {
 	m := model.(Model)
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
+	case tea.KeyMsg:
 		// pressed esc or q
 		if msg.Key() == terminput.KeyEscape || msg.Rune() == 'q' {
 			return m, tea.Quit
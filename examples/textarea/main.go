@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/key"
+	"github.com/tj/go-tea/textarea"
+	"github.com/tj/go-tea/theme"
+)
+
+// Model struct.
+type Model struct {
+	Message textarea.Model
+	Done    bool
+}
+
+// initialize function.
+func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
+	return Model{
+		Message: textarea.Model{
+			Placeholder:     "Describe your changes",
+			Width:           50,
+			MaxHeight:       8,
+			ShowLineNumbers: true,
+			CharLimit:       1000,
+		},
+	}, nil
+}
+
+// update function.
+func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.Cmd) {
+	m := model.(Model)
+
+	if key.Matches(msg, "esc") {
+		m.Done = true
+		return m, tea.Quit
+	}
+
+	message, cmd := textarea.Update(msg, m.Message)
+	m.Message = message
+	return m, cmd
+}
+
+// view function.
+func view(ctx context.Context, model tea.Model) string {
+	w := new(bytes.Buffer)
+	m := model.(Model)
+
+	// padding
+	fmt.Fprintf(w, "\n")
+	defer fmt.Fprintf(w, "\n")
+
+	if m.Done {
+		fmt.Fprintf(w, "%s\n", m.Message.Value)
+		return w.String()
+	}
+
+	fmt.Fprintf(w, "%s\n\n", textarea.View(m.Message))
+	fmt.Fprintf(w, "%s\n", theme.Current().Muted.Render("esc to finish"))
+
+	return w.String()
+}
+
+func main() {
+	program := tea.NewProgram(initialize, update, view)
+	err := program.Start(context.Background())
+	if err != nil {
+		log.Fatalf("error: %s\n", err)
+	}
+}
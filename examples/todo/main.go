@@ -8,13 +8,47 @@ import (
 
 	"github.com/tj/go-tea"
 	"github.com/tj/go-tea/input"
+	"github.com/tj/go-tea/list"
+	"github.com/tj/go-tea/theme"
 	"github.com/tj/go-terminput"
-
-	"github.com/tj/go-tea/examples/todo/list"
 )
 
-// AddedItem msg.
-type AddedItem string
+// Item is a todo item.
+type Item string
+
+// FilterValue implementation.
+func (i Item) FilterValue() string {
+	return string(i)
+}
+
+// Delegate renders todo items.
+type Delegate struct {
+	// Focused is true when the list is in focus.
+	Focused bool
+
+	// Removing is true when the active item is pending removal.
+	Removing bool
+}
+
+// Height implementation.
+func (d Delegate) Height(item list.Item) int {
+	return 1
+}
+
+// Render implementation.
+func (d Delegate) Render(item list.Item, index int, active bool) string {
+	t := theme.Current()
+	s := item.FilterValue()
+
+	switch {
+	case active && d.Focused && d.Removing:
+		return "  " + t.Error.Render(s+" (press again to confirm removal)")
+	case active && d.Focused:
+		return "  " + t.Selected.Render(s)
+	default:
+		return "  " + s
+	}
+}
 
 // Model struct.
 type Model struct {
@@ -31,16 +65,19 @@ type Model struct {
 	// AddingItem is used to indicate that we're adding
 	// a new item.
 	AddingItem bool
+
+	// Removing is true when an item is pending removal.
+	Removing bool
 }
 
 // initialize function.
 func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
 	return Model{
 		List: list.Model{
-			Items: []string{
-				"Buy groceries",
-				"Feed the ferrets",
-				"Feed the cats",
+			Items: []list.Item{
+				Item("Buy groceries"),
+				Item("Feed the ferrets"),
+				Item("Feed the cats"),
 			},
 		},
 	}, nil
@@ -51,37 +88,38 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 	m := model.(Model)
 
 	// delegate messages to input
+	var cmd tea.Cmd
 	if m.AddingItem {
-		m.Input = input.Update(msg, m.Input)
+		m.Input, cmd = input.Update(msg, m.Input)
 	}
 
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
+	case tea.KeyMsg:
 		switch msg.Key() {
 		case terminput.KeyUp:
+			m.Removing = false
 			if m.FocusingAddItem {
-				m.List.Disabled = false
 				m.FocusingAddItem = false
-			} else {
-				m.List = list.Update(msg, m.List)
+				return m, nil
 			}
-			return m, nil
+			m.List, cmd = list.Update(msg, m.List)
+			return m, cmd
 		case terminput.KeyDown:
+			m.Removing = false
+
 			// we were already at the end of the list
-			if m.List.Selected == len(m.List.Items)-1 {
+			if m.List.Selected >= len(m.List.Items)-1 {
 				// focus the add "button"
 				m.FocusingAddItem = true
-				m.List.Disabled = true
 				return m, nil
 			}
 
-			m.List.Disabled = false
-			m.List = list.Update(msg, m.List)
-			return m, nil
+			m.List, cmd = list.Update(msg, m.List)
+			return m, cmd
 		case terminput.KeyEnter:
 			// add a new item, clear the input, select the last one
 			if m.AddingItem {
-				m.List.Items = append(m.List.Items, m.Input.Value)
+				m.List.InsertItem(len(m.List.Items), Item(m.Input.Value))
 				m.Input = input.Model{}
 				m.AddingItem = false
 				m.List.Selected = len(m.List.Items) - 1
@@ -96,7 +134,17 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 
 			return m, nil
 		case terminput.KeyBackspace:
-			m.List = list.Update(msg, m.List)
+			if m.AddingItem || m.FocusingAddItem || len(m.List.Items) == 0 {
+				return m, cmd
+			}
+
+			// remove the item once confirmed
+			if m.Removing {
+				m.List.RemoveItem(m.List.Selected)
+				m.Removing = false
+			} else {
+				m.Removing = true
+			}
 			return m, nil
 		case terminput.KeyEscape:
 			return m, tea.Quit
@@ -108,7 +156,7 @@ func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.C
 		}
 	}
 
-	return m, nil
+	return m, cmd
 }
 
 // view function.
@@ -132,11 +180,15 @@ func view(ctx context.Context, model tea.Model) string {
 	}
 
 	// listing
+	m.List.Delegate = Delegate{
+		Focused:  !m.FocusingAddItem,
+		Removing: m.Removing,
+	}
 	fmt.Fprintf(w, list.View(m.List))
 
 	// add button
 	if m.FocusingAddItem {
-		fmt.Fprintf(w, "\n  \033[1mAdd Item\033[m\n")
+		fmt.Fprintf(w, "\n  %s\n", theme.Current().Selected.Render("Add Item"))
 	} else {
 		fmt.Fprintf(w, "\n  Add Item\n")
 	}
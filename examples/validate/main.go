@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"unicode"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/input"
+	"github.com/tj/go-terminput"
+)
+
+// Model struct.
+type Model struct {
+	Port     input.Model
+	Accepted bool
+}
+
+// initialize function.
+func initialize(ctx context.Context) (tea.Model, tea.Cmd) {
+	return Model{
+		Port: input.Model{
+			Prompt:      "  Port: ",
+			Placeholder: "8080",
+			CharLimit:   5,
+			Filter:      unicode.IsDigit,
+			Validate:    validatePort,
+		},
+	}, nil
+}
+
+// validatePort returns an error if s is not a valid port.
+func validatePort(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return errors.New("port is required")
+	}
+
+	if n < 1 || n > 65535 {
+		return errors.New("port must be between 1 and 65535")
+	}
+
+	return nil
+}
+
+// update function.
+func update(ctx context.Context, msg tea.Msg, model tea.Model) (tea.Model, tea.Cmd) {
+	m := model.(Model)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.Key() {
+		case terminput.KeyEnter:
+			if !m.Port.Valid() {
+				return m, tea.Bell
+			}
+			m.Accepted = true
+			return m, tea.Quit
+		case terminput.KeyEscape:
+			return m, tea.Quit
+		}
+	}
+
+	port, cmd := input.Update(msg, m.Port)
+	m.Port = port
+	return m, cmd
+}
+
+// view function.
+func view(ctx context.Context, model tea.Model) string {
+	w := new(bytes.Buffer)
+	m := model.(Model)
+
+	// padding
+	fmt.Fprintf(w, "\n")
+	defer fmt.Fprintf(w, "\n")
+
+	if m.Accepted {
+		fmt.Fprintf(w, "  Listening on :%s\n", m.Port.Value)
+	} else {
+		fmt.Fprintf(w, "%s\n", input.View(m.Port))
+	}
+
+	return w.String()
+}
+
+func main() {
+	program := tea.NewProgram(initialize, update, view)
+	err := program.Start(context.Background())
+	if err != nil {
+		log.Fatalf("error: %s\n", err)
+	}
+}
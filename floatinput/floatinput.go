@@ -0,0 +1,128 @@
+// Package floatinput provides a floating point number input.
+package floatinput
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/input"
+	"github.com/tj/go-tea/internal/number"
+)
+
+// Model is the floating point number input model.
+type Model struct {
+	// Input is the text input, which may be used to set the prompt,
+	// placeholder or a unit suffix such as "%". Its Filter is
+	// replaced to allow only the characters of a number.
+	Input input.Model
+
+	// Min is the minimum value, enforced when HasMin is set.
+	Min float64
+
+	// HasMin enables the minimum, which may be zero.
+	HasMin bool
+
+	// Max is the maximum value, enforced when HasMax is set.
+	Max float64
+
+	// HasMax enables the maximum, which may be zero.
+	HasMax bool
+
+	// Step is the amount added or subtracted by Up and Down. Defaults to 1.
+	Step float64
+
+	// Precision is the number of decimal places of values set by Up and Down.
+	// Defaults to the decimal places of the value or Step, whichever is greater.
+	Precision int
+
+	// Err is the current parse or bounds error, which is rendered beneath the input.
+	Err error
+}
+
+// Value returns the value, the minimum when empty, or 0 when invalid.
+func (m *Model) Value() float64 {
+	n := m.number()
+	return number.AsFloat(n.Value())
+}
+
+// SetValue sets the value.
+func (m *Model) SetValue(v float64) {
+	n := m.number()
+	n.SetValue(value(v))
+	m.Input, m.Err = n.Input, n.Err
+}
+
+// Valid validates the current value, returning true if it is valid.
+// Unlike while editing, an empty value is invalid.
+func (m *Model) Valid() bool {
+	n := m.number()
+	ok := n.Valid()
+	m.Err = n.Err
+	return ok
+}
+
+// Update function.
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	n, cmd := number.Update(msg, m.number())
+	m.Input, m.Err = n.Input, n.Err
+	return m, cmd
+}
+
+// View function.
+func View(m Model) string {
+	return number.View(m.number())
+}
+
+// number returns the numeric input model.
+func (m *Model) number() number.Model {
+	step := m.Step
+	if step == 0 {
+		step = 1
+	}
+
+	return number.Model{
+		Type:  float,
+		Input: m.Input,
+		Min:   bound(m.Min, m.HasMin),
+		Max:   bound(m.Max, m.HasMax),
+		Step:  number.Float(step, m.Precision),
+		Err:   m.Err,
+	}
+}
+
+// float is the floating point number type.
+var float = number.Type{
+	Parse:  parse,
+	Filter: filter,
+	Zero:   value(0),
+}
+
+// value returns n as a value.
+func value(n float64) number.Value {
+	return number.Float(n, 0)
+}
+
+// bound returns n as a bound, or nil when unset.
+func bound(n float64, ok bool) number.Value {
+	if !ok {
+		return nil
+	}
+	return value(n)
+}
+
+// parse a floating point number.
+func parse(s string) (number.Value, error) {
+	n, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+		return nil, errors.New("must be a number")
+	}
+	return value(n), nil
+}
+
+// filter returns true for the characters of a number.
+func filter(r rune) bool {
+	return r >= '0' && r <= '9' || strings.ContainsRune(".-+eE", r)
+}
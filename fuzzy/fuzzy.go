@@ -0,0 +1,137 @@
+// Package fuzzy provides fuzzy matching for filtering lists, where the
+// characters of a pattern must appear in order, but not necessarily together.
+package fuzzy
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tj/go-tea/style"
+)
+
+// Scores used to rank matches.
+const (
+	scoreMatch       = 1
+	scoreConsecutive = 5
+	scoreBoundary    = 10
+	penaltyGap       = 1
+)
+
+// Match is a string matching a pattern.
+type Match struct {
+	// Str is the matched string.
+	Str string
+
+	// Index is the index of the string in the list searched.
+	Index int
+
+	// Score is the quality of the match, higher is better.
+	Score int
+
+	// MatchedIndexes is the byte offsets of the matched characters in Str.
+	MatchedIndexes []int
+}
+
+// Find returns the strings matching pattern, ignoring case, with the best
+// matches first and ties kept in their original order. An empty pattern
+// matches every string.
+func Find(pattern string, data []string) (matches []Match) {
+	for i, s := range data {
+		if m, ok := MatchString(pattern, s); ok {
+			m.Index = i
+			matches = append(matches, m)
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	return
+}
+
+// MatchString returns the match of pattern in s, or false when it does not match.
+// Matches at the start of words and consecutive matches are ranked higher.
+func MatchString(pattern, s string) (Match, bool) {
+	m := Match{Str: s}
+	p := []rune(strings.ToLower(pattern))
+
+	var j int
+	var consecutive bool
+	prev := rune(-1)
+
+	for i, r := range s {
+		if j < len(p) && unicode.ToLower(r) == p[j] {
+			m.MatchedIndexes = append(m.MatchedIndexes, i)
+			m.Score += scoreMatch
+			if consecutive {
+				m.Score += scoreConsecutive
+			}
+			if boundary(prev, r) {
+				m.Score += scoreBoundary
+			}
+			consecutive = true
+			j++
+		} else {
+			if j > 0 && j < len(p) {
+				m.Score -= penaltyGap
+			}
+			consecutive = false
+		}
+		prev = r
+	}
+
+	if j < len(p) {
+		return Match{}, false
+	}
+
+	return m, true
+}
+
+// Highlight renders s with the characters at the matched byte offsets
+// in the highlight style, and the remaining characters in the base style.
+func Highlight(s string, matched []int, base, highlight style.Style) string {
+	var b strings.Builder
+
+	isMatched := make(map[int]bool, len(matched))
+	for _, i := range matched {
+		isMatched[i] = true
+	}
+
+	start := 0
+	for start < len(s) {
+		hl := isMatched[start]
+
+		end := start
+		for end < len(s) && isMatched[end] == hl {
+			_, size := utf8.DecodeRuneInString(s[end:])
+			end += size
+		}
+
+		if hl {
+			b.WriteString(highlight.Render(s[start:end]))
+		} else {
+			b.WriteString(base.Render(s[start:end]))
+		}
+
+		start = end
+	}
+
+	return b.String()
+}
+
+// boundary returns true if r is the start of a word, following prev.
+func boundary(prev, r rune) bool {
+	switch {
+	case prev < 0:
+		return true
+	case unicode.IsSpace(prev) || unicode.IsPunct(prev):
+		return true
+	case unicode.IsLower(prev) && unicode.IsUpper(r):
+		return true
+	default:
+		return false
+	}
+}
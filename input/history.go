@@ -0,0 +1,323 @@
+package input
+
+import (
+	"bufio"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"unicode"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/key"
+	"github.com/tj/go-tea/text"
+	"github.com/tj/go-terminput"
+)
+
+// DefaultHistorySize is the default maximum number of history entries.
+const DefaultHistorySize = 1000
+
+// History is a list of previously entered values, most recent last,
+// which may be persisted to a file and shared between sessions.
+type History struct {
+	// Path is the file the history is persisted to, or empty to keep it in memory.
+	Path string
+
+	// Max is the maximum number of entries. Defaults to DefaultHistorySize.
+	Max int
+
+	mu      sync.Mutex
+	entries []string
+}
+
+// NewHistory returns a new history persisted to path, loading
+// any existing entries. An empty path keeps the history in memory.
+func NewHistory(path string) (*History, error) {
+	h := &History{Path: path}
+
+	if path == "" {
+		return h, nil
+	}
+
+	entries, err := readHistory(path)
+	if err != nil {
+		return nil, err
+	}
+
+	h.entries = entries
+	return h, nil
+}
+
+// Entries returns a copy of the entries, most recent last.
+func (h *History) Entries() []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]string{}, h.entries...)
+}
+
+// Add a value to the history, removing any previous occurrence and the oldest
+// entries exceeding Max. When persisted the file is re-read while holding a
+// lock, so entries added by other processes are kept, and replaced atomically.
+func (h *History) Add(value string) error {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.Path == "" {
+		h.entries = h.add(h.entries, value)
+		return nil
+	}
+
+	unlock, err := lock(h.Path + ".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	entries, err := readHistory(h.Path)
+	if err != nil {
+		return err
+	}
+
+	entries = h.add(entries, value)
+
+	err = writeHistory(h.Path, entries)
+	if err != nil {
+		return err
+	}
+
+	h.entries = entries
+	return nil
+}
+
+// add value to the entries.
+func (h *History) add(entries []string, value string) []string {
+	max := h.Max
+	if max <= 0 {
+		max = DefaultHistorySize
+	}
+
+	var out []string
+	for _, e := range entries {
+		if e != value {
+			out = append(out, e)
+		}
+	}
+	out = append(out, value)
+
+	if len(out) > max {
+		out = out[len(out)-max:]
+	}
+
+	return out
+}
+
+// prev returns the index of the entry before i containing query, or -1.
+func (h *History) prev(i int, query string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for i = clamp(i, 0, len(h.entries)) - 1; i >= 0; i-- {
+		if strings.Contains(h.entries[i], query) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// entry returns the entry at index i, or false when out of range.
+func (h *History) entry(i int) (string, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if i < 0 || i >= len(h.entries) {
+		return "", false
+	}
+
+	return h.entries[i], true
+}
+
+// len returns the number of entries.
+func (h *History) len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.entries)
+}
+
+// escaper escapes entries so each occupies a single line.
+var escaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+// unescaper reverses escaper.
+var unescaper = strings.NewReplacer(`\\`, `\`, `\n`, "\n")
+
+// readHistory reads the entries from path, a missing file has no entries.
+func readHistory(path string) (entries []string, err error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	s.Buffer(nil, 1<<20)
+	for s.Scan() {
+		if s.Text() != "" {
+			entries = append(entries, unescaper.Replace(s.Text()))
+		}
+	}
+
+	return entries, s.Err()
+}
+
+// writeHistory writes the entries to a temporary file which
+// is renamed to path, so readers never observe a partial write.
+func writeHistory(path string, entries []string) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	w := bufio.NewWriter(f)
+	for _, e := range entries {
+		w.WriteString(escaper.Replace(e))
+		w.WriteByte('\n')
+	}
+
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Chmod(0600); err != nil {
+		f.Close()
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// lock acquires an exclusive lock on path, blocking until it is available.
+func lock(path string) (unlock func(), err error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return func() {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+		f.Close()
+	}, nil
+}
+
+// recall the history entry n entries back, where 0 is the value being edited.
+func recall(m Model, n int) (Model, tea.Cmd) {
+	total := m.History.len()
+	if n < 0 || n > total {
+		return m, tea.Bell
+	}
+
+	if m.recall == 0 {
+		m.draft = m.Value
+	}
+
+	m.recall = n
+	if n == 0 {
+		m.Value = m.draft
+	} else {
+		m.Value, _ = m.History.entry(total - n)
+	}
+
+	m.pos = len(text.Graphemes(m.Value))
+	return m, nil
+}
+
+// search handles a key during a reverse incremental search,
+// returning false if the key ends the search.
+func search(m Model, k tea.KeyMsg, km *KeyMap) (Model, tea.Cmd, bool) {
+	switch {
+	case key.Matches(k, km.HistorySearch...):
+		i := m.History.prev(m.match, m.query)
+		if i < 0 {
+			m.failed = true
+			return m, tea.Bell, true
+		}
+		return found(m, i), nil, true
+	case key.Matches(k, km.DeleteCharacterBackward...):
+		if m.query == "" {
+			return m, tea.Bell, true
+		}
+		g := text.Graphemes(m.query)
+		m.query = join(g[:len(g)-1])
+		if m.query == "" {
+			m.Value = m.draft
+			m.pos = len(text.Graphemes(m.Value))
+			m.match = m.History.len()
+			m.recall = 0
+			m.failed = false
+			return m, nil, true
+		}
+		return found(m, m.History.prev(m.History.len(), m.query)), nil, true
+	case key.Matches(k, km.CancelSearch...):
+		m.Value = m.draft
+		m.pos = len(text.Graphemes(m.Value))
+		m.recall = 0
+		m.searching = false
+		return m, nil, true
+	case k.Key() == terminput.KeyRune && !k.Alt() && !unicode.IsControl(k.Rune()):
+		m.query += string(k.Rune())
+		i := m.History.prev(m.match+1, m.query)
+		if i < 0 {
+			m.failed = true
+			return m, tea.Bell, true
+		}
+		return found(m, i), nil, true
+	default:
+		return m, nil, false
+	}
+}
+
+// found sets the value to the history entry at index i matching the
+// query, with the cursor at the start of the match.
+func found(m Model, i int) Model {
+	value, ok := m.History.entry(i)
+	n := strings.Index(value, m.query)
+	if !ok || n < 0 {
+		m.failed = true
+		return m
+	}
+
+	m.match = i
+	m.failed = false
+	m.recall = m.History.len() - i
+	m.Value = value
+	m.pos = len(text.Graphemes(value[:n]))
+	return m
+}
+
+// searchPrompt returns the prompt displayed when searching.
+func searchPrompt(m Model) string {
+	if m.failed {
+		return "(failed reverse-i-search)`" + m.query + "': "
+	}
+	return "(reverse-i-search)`" + m.query + "': "
+}
@@ -2,151 +2,646 @@
 package input
 
 import (
-	"bytes"
-	"fmt"
+	"context"
+	"strings"
 	"unicode"
 
 	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/internal/cursor"
+	"github.com/tj/go-tea/key"
+	"github.com/tj/go-tea/text"
+	"github.com/tj/go-tea/theme"
+	"github.com/tj/go-tea/undo"
 	"github.com/tj/go-terminput"
 )
 
+// EchoMode is the way in which the value is displayed.
+type EchoMode int
+
+// Echo modes available.
+const (
+	// EchoNormal displays the value as-is.
+	EchoNormal EchoMode = iota
+
+	// EchoPassword displays a mask character in place of each character.
+	EchoPassword
+
+	// EchoNone displays nothing.
+	EchoNone
+)
+
 // Model is the input model.
 type Model struct {
 	// Value is the text input value.
 	Value string
 
-	// pos is the position of the cursor.
+	// Prompt is the text rendered before the value, such as "> ".
+	Prompt string
+
+	// Placeholder is the text rendered when the value is empty.
+	Placeholder string
+
+	// Suffix is the text rendered after the value, such as a unit.
+	Suffix string
+
+	// Width is the number of columns used to display the value, excluding the prompt.
+	// Values which do not fit are scrolled horizontally to keep the cursor in view.
+	// Defaults to the width of the value.
+	Width int
+
+	// EchoMode is the way in which the value is displayed. Defaults to EchoNormal.
+	EchoMode EchoMode
+
+	// MaskRune is the character displayed in place of each character
+	// when EchoMode is EchoPassword. Defaults to '*'.
+	MaskRune rune
+
+	// CharLimit is the maximum number of characters, or 0 for no limit.
+	CharLimit int
+
+	// Filter returns true if a typed character is allowed, disallowed
+	// characters are rejected with the bell. Defaults to allowing all characters.
+	Filter func(rune) bool
+
+	// Validate returns an error when the value is invalid, it is
+	// called whenever the value changes.
+	Validate func(string) error
+
+	// Err is the current validation error, which is rendered beneath the input.
+	Err error
+
+	// Suggestions is a set of completions for the value. The first completion
+	// matching the value is displayed after it, and may be accepted or cycled.
+	Suggestions []string
+
+	// Suggest is a function returning completions for the value, which is
+	// called asynchronously whenever the value changes, taking precedence
+	// over Suggestions.
+	Suggest func(ctx context.Context, value string) ([]string, error)
+
+	// History is the list of previously entered values, which are recalled
+	// with Up and Down, and searched incrementally with Ctrl-R, unless the
+	// value is masked. Values are not added automatically, call History.Add
+	// when the value is submitted.
+	History *History
+
+	// KeyMap is the set of key bindings. Defaults to DefaultKeyMap.
+	KeyMap *KeyMap
+
+	// edits is the undo and redo history.
+	edits undo.Stack
+
+	// pasting is true when the clipboard has been requested.
+	pasting bool
+
+	// id of the input, used to route asynchronous suggestions.
+	id int64
+
+	// suggestions returned by Suggest.
+	suggestions []string
+
+	// suggestion is the index of the active suggestion.
+	suggestion int
+
+	// pos is the position of the cursor in grapheme clusters.
 	pos int
+
+	// offset is the first visible grapheme cluster when scrolled.
+	offset int
+
+	// killRing is the killed text available to yank, most recent last.
+	killRing []string
+
+	// killing is true when the previous key killed text, so
+	// consecutive kills are combined into a single entry.
+	killing bool
+
+	// last is the value when Update last returned, used to detect
+	// changes made outside of Update, such as clearing the value.
+	last string
+
+	// recall is the number of entries back in the history
+	// of the recalled value, or 0 when none is recalled.
+	recall int
+
+	// draft is the value before recalling or searching the history.
+	draft string
+
+	// searching is true during a reverse incremental search.
+	searching bool
+
+	// query is the reverse incremental search query.
+	query string
+
+	// match is the index of the history entry matching the query.
+	match int
+
+	// failed is true when no history entry matches the query.
+	failed bool
+}
+
+// maxKills is the maximum number of kill ring entries.
+const maxKills = 10
+
+// Valid validates the current value, returning true if it is valid.
+func (m *Model) Valid() bool {
+	if m.Validate == nil {
+		return true
+	}
+	m.Err = m.Validate(m.Value)
+	return m.Err == nil
+}
+
+// SetValue sets the value, moving the cursor to the end.
+func (m *Model) SetValue(s string) {
+	m.Value = s
+	m.pos = len(text.Graphemes(s))
 }
 
 // Update function.
-func Update(msg tea.Msg, m Model) Model {
-	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
-		switch msg.Key() {
-		case terminput.KeyBackspace:
-			if m.pos > 0 {
-				m.Value = m.Value[:m.pos-1] + m.Value[m.pos:]
-				m.pos--
-			} else {
-				bell()
-			}
-			return m
-		case terminput.KeyLeft:
-			if m.pos > 0 {
-				if msg.Alt() {
-					m.pos -= wordLeft(m)
-				} else {
-					m.pos--
-				}
-			} else {
-				bell()
-			}
-			return m
-		case terminput.KeyRight:
-			if m.pos < len(m.Value) {
-				if msg.Alt() {
-					m.pos += wordRight(m)
-				} else {
-					m.pos++
-				}
-			} else {
-				bell()
-			}
-			return m
-		case terminput.KeyRune:
-			m.Value = m.Value[:m.pos] + string(msg.Rune()) + m.Value[m.pos:]
-			m.pos++
-			return m
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	if m.id == 0 {
+		m.id = nextID()
+	}
+
+	// suggestions
+	if msg, ok := msg.(suggestionsMsg); ok {
+		if msg.id == m.id && msg.value == m.Value {
+			m.suggestions = msg.suggestions
+			m.suggestion = 0
 		}
+		return m, nil
 	}
-	return m
+
+	// paste
+	if msg, ok := msg.(tea.ClipboardMsg); ok {
+		if !m.pasting {
+			return m, nil
+		}
+		m.pasting = false
+		return paste(m, string(msg))
+	}
+
+	k, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	km := m.KeyMap
+	if km == nil {
+		km = &DefaultKeyMap
+	}
+
+	// changed outside of Update
+	if m.Value != m.last {
+		m.recall = 0
+		m.searching = false
+		m.edits = undo.Stack{}
+	}
+
+	g := text.Graphemes(m.Value)
+	m.pos = clamp(m.pos, 0, len(g))
+	killing := m.killing
+	m.killing = false
+	prev := m.Value
+
+	var cmd tea.Cmd
+	var recalling bool
+
+	// search keys, any other key ends the search
+	if m.searching && recallable(m) {
+		var ok bool
+		m, cmd, ok = search(m, k, km)
+		if ok {
+			m.edits.Break()
+			return changed(m, prev, cmd)
+		}
+	}
+	m.searching = false
+
+	completing := m.pos == len(g) && ghost(m) != ""
+	before := undo.State{Text: m.Value, Pos: m.pos}
+	kind := undo.Change
+	var undoing bool
+
+	switch {
+	case key.Matches(k, km.Undo...):
+		s, ok := m.edits.Undo(before)
+		if !ok {
+			return m, tea.Bell
+		}
+		m.Value, m.pos = s.Text, s.Pos
+		undoing = true
+	case key.Matches(k, km.Redo...):
+		s, ok := m.edits.Redo(before)
+		if !ok {
+			return m, tea.Bell
+		}
+		m.Value, m.pos = s.Text, s.Pos
+		undoing = true
+	case completing && (key.Matches(k, km.AcceptSuggestion...) || key.Matches(k, km.CharacterRight...)):
+		m.Value = suggestion(m)
+		m.pos = len(text.Graphemes(m.Value))
+	case key.Matches(k, km.NextSuggestion...):
+		if len(matches(m)) == 0 {
+			return m, tea.Bell
+		}
+		m.suggestion++
+	case key.Matches(k, km.PrevSuggestion...):
+		if len(matches(m)) == 0 {
+			return m, tea.Bell
+		}
+		m.suggestion--
+	case recallable(m) && key.Matches(k, km.HistoryPrev...):
+		m, cmd = recall(m, m.recall+1)
+		recalling = true
+	case recallable(m) && key.Matches(k, km.HistoryNext...):
+		m, cmd = recall(m, m.recall-1)
+		recalling = true
+	case recallable(m) && key.Matches(k, km.HistorySearch...):
+		if m.recall == 0 {
+			m.draft = m.Value
+		}
+		m.searching = true
+		m.query = ""
+		m.match = m.History.len()
+		m.failed = false
+		recalling = true
+	case key.Matches(k, km.DeleteCharacterBackward...):
+		if m.pos == 0 {
+			return m, tea.Bell
+		}
+		m.Value = join(g[:m.pos-1]) + join(g[m.pos:])
+		m.pos--
+		kind = undo.Delete
+	case key.Matches(k, km.DeleteCharacterForward...):
+		if m.pos == len(g) {
+			return m, tea.Bell
+		}
+		m.Value = join(g[:m.pos]) + join(g[m.pos+1:])
+		kind = undo.Delete
+	case key.Matches(k, km.WordLeft...):
+		if m.pos == 0 {
+			return m, tea.Bell
+		}
+		m.pos -= wordLeft(m, g)
+	case key.Matches(k, km.WordRight...):
+		if m.pos == len(g) {
+			return m, tea.Bell
+		}
+		m.pos += wordRight(m, g)
+	case key.Matches(k, km.CharacterLeft...):
+		if m.pos == 0 {
+			return m, tea.Bell
+		}
+		m.pos--
+	case key.Matches(k, km.CharacterRight...):
+		if m.pos == len(g) {
+			return m, tea.Bell
+		}
+		m.pos++
+	case key.Matches(k, km.LineStart...):
+		m.pos = 0
+	case key.Matches(k, km.LineEnd...):
+		m.pos = len(g)
+	case key.Matches(k, km.DeleteWordBackward...):
+		m = kill(m, g, m.pos-wordLeft(m, g), m.pos, killing)
+	case key.Matches(k, km.DeleteWordForward...):
+		m = kill(m, g, m.pos, m.pos+wordRight(m, g), killing)
+	case key.Matches(k, km.DeleteBeforeCursor...):
+		m = kill(m, g, 0, m.pos, killing)
+	case key.Matches(k, km.DeleteAfterCursor...):
+		m = kill(m, g, m.pos, len(g), killing)
+	case key.Matches(k, km.Copy...):
+		if m.EchoMode != EchoNormal || m.Value == "" {
+			return m, tea.Bell
+		}
+		cmd = tea.SetClipboard(m.Value)
+	case key.Matches(k, km.Paste...):
+		m.pasting = true
+		cmd = tea.ReadClipboard
+	case key.Matches(k, km.Yank...):
+		if len(m.killRing) == 0 {
+			return m, tea.Bell
+		}
+		m, cmd = insert(m, g, m.killRing[len(m.killRing)-1])
+	case k.Key() == terminput.KeyRune && !k.Alt() && !unicode.IsControl(k.Rune()):
+		m, cmd = insert(m, g, string(k.Rune()))
+		kind = undo.Insert
+	}
+
+	if m.Value != prev && !recalling {
+		m.recall = 0
+	}
+
+	switch {
+	case undoing:
+	case m.Value != prev:
+		m.edits.Push(before, kind)
+	default:
+		m.edits.Break()
+	}
+
+	return changed(m, prev, cmd)
+}
+
+// paste s at the cursor, replacing line breaks and tabs
+// with spaces and removing other control characters.
+func paste(m Model, s string) (Model, tea.Cmd) {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			return ' '
+		case unicode.IsControl(r):
+			return -1
+		default:
+			return r
+		}
+	}, strings.Replace(s, "\r\n", "\n", -1))
+	prev := m.Value
+	before := undo.State{Text: m.Value, Pos: m.pos}
+
+	m.searching = false
+	m, cmd := insert(m, text.Graphemes(m.Value), s)
+	if m.Value != prev {
+		m.recall = 0
+		m.edits.Push(before, undo.Change)
+	}
+
+	return changed(m, prev, cmd)
+}
+
+// changed updates the model after a key is handled, validating
+// and fetching suggestions when the value has changed from prev.
+func changed(m Model, prev string, cmd tea.Cmd) (Model, tea.Cmd) {
+	if m.Value != prev {
+		m.suggestion = 0
+
+		if m.Validate != nil {
+			m.Err = m.Validate(m.Value)
+		}
+
+		if m.Suggest != nil {
+			cmd = batch(cmd, suggest(m))
+		}
+	}
+
+	if m.Width > 0 {
+		m.offset = scroll(display(m), m.pos, m.offset, m.Width-2)
+	}
+
+	m.last = m.Value
+	return m, cmd
 }
 
 // View function.
 func View(m Model) string {
-	if m.Value == "" {
-		return cursor(" ")
-	}
-	w := new(bytes.Buffer)
-	fmt.Fprintf(w, "%s", m.Value[:m.pos])
-	if m.pos < len(m.Value) {
-		fmt.Fprintf(w, "")
-		fmt.Fprintf(w, cursor(string(m.Value[m.pos])))
-		fmt.Fprintf(w, m.Value[m.pos+1:])
-	} else {
-		fmt.Fprintf(w, cursor(" "))
-	}
-	return w.String()
-}
-
-// wordLeft util.
-func wordLeft(m Model) (size int) {
-	// TODO: support utf8
-	i := m.pos - 1
-
-	// skip whitespace
-	for i >= 0 {
-		if unicode.IsSpace(rune(m.Value[i])) {
-			size++
-			i--
-		} else {
-			break
+	t := theme.Current()
+
+	s := view(m)
+	if m.Suffix != "" {
+		s += t.Muted.Render(m.Suffix)
+	}
+
+	if m.Err == nil {
+		return s
+	}
+
+	indent := strings.Repeat(" ", text.Width(m.Prompt))
+	return s + "\n" + indent + t.Error.Render(m.Err.Error())
+}
+
+// view renders the input.
+func view(m Model) string {
+	t := theme.Current()
+
+	// search
+	if m.searching && recallable(m) {
+		m.Prompt = searchPrompt(m)
+		m.Placeholder = ""
+	}
+
+	// placeholder
+	if m.Value == "" && m.Placeholder != "" {
+		p := m.Placeholder
+		if m.Width > 0 {
+			p = text.Truncate(p, m.Width, "")
+		}
+		g := text.Graphemes(p)
+		return m.Prompt + cursor.Render(g[0]) + t.Muted.Render(join(g[1:]))
+	}
+
+	// hidden
+	if m.EchoMode == EchoNone {
+		return m.Prompt + cursor.Render(" ")
+	}
+
+	g := display(m)
+	pos := clamp(m.pos, 0, len(g))
+
+	// fits
+	if m.Width <= 0 || text.Width(join(g))+1 <= m.Width {
+		s := ghost(m)
+		if m.Width > 0 {
+			s = text.Truncate(s, m.Width-text.Width(m.Value), "")
+		}
+		if s == "" || pos < len(g) {
+			return m.Prompt + cursor.Line(g, 0, len(g), pos)
 		}
+		gs := text.Graphemes(s)
+		return m.Prompt + m.Value + cursor.Render(t.Muted.Render(gs[0])) + t.Muted.Render(join(gs[1:]))
 	}
 
-	// skip word
-	for i >= 0 {
-		if !unicode.IsSpace(rune(m.Value[i])) {
-			size++
-			i--
-		} else {
+	// scrolled, with an overflow indicator on each side
+	w := max(1, m.Width-2)
+	offset := scroll(g, pos, m.offset, w)
+
+	end, n := offset, 0
+	for end < len(g) {
+		gw := text.GraphemeWidth(g[end])
+		if n+gw > w {
 			break
 		}
+		n += gw
+		end++
+	}
+
+	left, right := " ", " "
+	if offset > 0 {
+		left = t.Muted.Render("…")
+	}
+	if end < len(g) {
+		right = t.Muted.Render("…")
 	}
 
-	return
+	return m.Prompt + left + text.PadRight(cursor.Line(g, offset, end, pos), w) + right
 }
 
-// wordRight util.
-func wordRight(m Model) (size int) {
-	// TODO: support utf8
-	i := m.pos
+// display returns the grapheme clusters displayed for the value,
+// which are masked in EchoPassword mode, and empty in EchoNone mode.
+func display(m Model) []string {
+	g := text.Graphemes(m.Value)
 
-	// skip word
-	for i < len(m.Value) {
-		if !unicode.IsSpace(rune(m.Value[i])) {
-			size++
-			i++
-		} else {
-			break
+	switch m.EchoMode {
+	case EchoPassword:
+		mask := m.MaskRune
+		if mask == 0 {
+			mask = '*'
+		}
+		for i := range g {
+			g[i] = string(mask)
 		}
+	case EchoNone:
+		g = nil
 	}
 
-	// skip whitespace
-	for i < len(m.Value) {
-		if unicode.IsSpace(rune(m.Value[i])) {
-			size++
-			i++
-		} else {
-			break
+	return g
+}
+
+// scroll returns the offset of the first visible grapheme cluster,
+// keeping the cursor visible within w columns.
+func scroll(g []string, pos, offset, w int) int {
+	w = max(1, w)
+	pos = clamp(pos, 0, len(g))
+	offset = clamp(offset, 0, pos)
+
+	// cursor width
+	cw := 1
+	if pos < len(g) {
+		cw = max(1, text.GraphemeWidth(g[pos]))
+	}
+
+	// scroll right until the cursor fits
+	for offset < pos && text.Width(join(g[offset:pos]))+cw > w {
+		offset++
+	}
+
+	// scroll left to fill any space at the end
+	for offset > 0 && text.Width(join(g[offset-1:]))+1 <= w {
+		offset--
+	}
+
+	return offset
+}
+
+// insert s at the cursor, which may combine with the preceding
+// grapheme cluster, for example when typing a combining accent.
+// Text rejected by the filter or exceeding the limit rings the bell.
+func insert(m Model, g []string, s string) (Model, tea.Cmd) {
+	if m.Filter != nil {
+		for _, r := range s {
+			if !m.Filter(r) {
+				return m, tea.Bell
+			}
 		}
 	}
 
-	return
+	before := join(g[:m.pos]) + s
+	value := before + join(g[m.pos:])
+
+	if m.CharLimit > 0 && len(text.Graphemes(value)) > m.CharLimit {
+		return m, tea.Bell
+	}
+
+	m.Value = value
+	m.pos = len(text.Graphemes(before))
+	return m, nil
 }
 
-// isWord character.
-func isWord(c rune) bool {
-	return !unicode.IsSpace(c)
+// kill the grapheme clusters from start to end, adding them to the kill ring.
+// When the previous key also killed text the entry is extended instead,
+// appending text killed after the cursor and prepending text before it.
+func kill(m Model, g []string, start, end int, killing bool) Model {
+	if start == end {
+		return m
+	}
+
+	killed := join(g[start:end])
+	forward := start == m.pos
+	ring := append([]string{}, m.killRing...)
+
+	m.Value = join(g[:start]) + join(g[end:])
+	m.pos = start
+	m.killing = true
+
+	switch {
+	case killing && len(ring) > 0 && forward:
+		ring[len(ring)-1] += killed
+	case killing && len(ring) > 0:
+		ring[len(ring)-1] = killed + ring[len(ring)-1]
+	default:
+		ring = append(ring, killed)
+	}
+
+	if len(ring) > maxKills {
+		ring = ring[1:]
+	}
+
+	m.killRing = ring
+	return m
+}
+
+// batch returns a command performing both commands, either of which may be nil.
+func batch(a, b tea.Cmd) tea.Cmd {
+	switch {
+	case a == nil:
+		return b
+	case b == nil:
+		return a
+	default:
+		return tea.Batch(a, b)
+	}
+}
+
+// join grapheme clusters.
+func join(g []string) string {
+	return strings.Join(g, "")
+}
+
+// recallable returns true if the history can be recalled and searched,
+// which is disabled when the value is masked so that neither the entries
+// nor the search query are displayed.
+func recallable(m Model) bool {
+	return m.History != nil && m.EchoMode == EchoNormal
+}
+
+// wordLeft returns the number of grapheme clusters to the start of the previous word.
+// Word boundaries are not revealed when the value is masked, so the value is
+// treated as a single word.
+func wordLeft(m Model, g []string) int {
+	if m.EchoMode != EchoNormal {
+		return m.pos
+	}
+
+	return text.WordLeft(g, m.pos)
+}
+
+// wordRight returns the number of grapheme clusters to the start of the next word.
+// Word boundaries are not revealed when the value is masked, so the value is
+// treated as a single word.
+func wordRight(m Model, g []string) int {
+	if m.EchoMode != EchoNormal {
+		return len(g) - m.pos
+	}
+
+	return text.WordRight(g, m.pos)
 }
 
-// cursor styling.
-func cursor(s string) string {
-	return fmt.Sprintf("\033[48;5;61m%s\033[0m", s)
+// max returns the maximum of two ints.
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
 }
 
-// bell sound.
-func bell() {
-	fmt.Printf("\a")
+// clamp n between min and max.
+func clamp(n, min, max int) int {
+	if n < min {
+		return min
+	}
+	if n > max {
+		return max
+	}
+	return n
 }
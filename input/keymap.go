@@ -0,0 +1,106 @@
+package input
+
+// KeyMap is a set of key bindings, using key names such as "ctrl+a".
+type KeyMap struct {
+	// CharacterLeft moves the cursor one character left.
+	CharacterLeft []string
+
+	// CharacterRight moves the cursor one character right.
+	CharacterRight []string
+
+	// WordLeft moves the cursor to the start of the previous word.
+	WordLeft []string
+
+	// WordRight moves the cursor to the start of the next word.
+	WordRight []string
+
+	// LineStart moves the cursor to the start of the input.
+	LineStart []string
+
+	// LineEnd moves the cursor to the end of the input.
+	LineEnd []string
+
+	// DeleteCharacterBackward deletes the character before the cursor.
+	DeleteCharacterBackward []string
+
+	// DeleteCharacterForward deletes the character under the cursor.
+	DeleteCharacterForward []string
+
+	// DeleteWordBackward kills the word before the cursor.
+	DeleteWordBackward []string
+
+	// DeleteWordForward kills the word after the cursor.
+	DeleteWordForward []string
+
+	// DeleteAfterCursor kills the text from the cursor to the end of the input.
+	DeleteAfterCursor []string
+
+	// DeleteBeforeCursor kills the text from the start of the input to the cursor.
+	DeleteBeforeCursor []string
+
+	// Yank inserts the most recently killed text.
+	Yank []string
+
+	// AcceptSuggestion completes the value with the active suggestion.
+	// CharacterRight also accepts it when the cursor is at the end of the input.
+	AcceptSuggestion []string
+
+	// NextSuggestion activates the next suggestion.
+	NextSuggestion []string
+
+	// PrevSuggestion activates the previous suggestion.
+	PrevSuggestion []string
+
+	// HistoryPrev recalls the previous history entry.
+	HistoryPrev []string
+
+	// HistoryNext recalls the next history entry, or the value being edited.
+	HistoryNext []string
+
+	// HistorySearch starts a reverse incremental search of the history,
+	// or finds the previous match when searching.
+	HistorySearch []string
+
+	// CancelSearch ends the search, restoring the value being edited.
+	CancelSearch []string
+
+	// Copy copies the value to the clipboard, unless it is masked.
+	Copy []string
+
+	// Paste inserts the contents of the clipboard at the cursor.
+	Paste []string
+
+	// Undo reverts the most recent edit, consecutive typing is undone together.
+	Undo []string
+
+	// Redo reapplies the most recently undone edit.
+	Redo []string
+}
+
+// DefaultKeyMap is the default set of key bindings, following readline.
+var DefaultKeyMap = KeyMap{
+	CharacterLeft:           []string{"left", "ctrl+b"},
+	CharacterRight:          []string{"right", "ctrl+f"},
+	WordLeft:                []string{"alt+left", "alt+b"},
+	WordRight:               []string{"alt+right", "alt+f"},
+	LineStart:               []string{"home", "ctrl+a"},
+	LineEnd:                 []string{"end", "ctrl+e"},
+	DeleteCharacterBackward: []string{"backspace"},
+	DeleteCharacterForward:  []string{"delete", "ctrl+d"},
+	DeleteWordBackward:      []string{"ctrl+w", "alt+backspace"},
+	DeleteWordForward:       []string{"alt+d", "alt+delete"},
+	DeleteAfterCursor:       []string{"ctrl+k"},
+	DeleteBeforeCursor:      []string{"ctrl+u"},
+	Yank:                    []string{"ctrl+y"},
+	AcceptSuggestion:        []string{"tab"},
+	NextSuggestion:          []string{"ctrl+n"},
+	PrevSuggestion:          []string{"ctrl+p"},
+	HistoryPrev:             []string{"up"},
+	HistoryNext:             []string{"down"},
+	HistorySearch:           []string{"ctrl+r"},
+	CancelSearch:            []string{"ctrl+g", "esc"},
+	Copy:                    []string{"alt+w", "ctrl+o"},
+	Paste:                   []string{"ctrl+v"},
+	Undo:                    []string{"ctrl+z", "ctrl+_"},
+	Redo:                    []string{"ctrl+^"},
+}
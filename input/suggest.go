@@ -0,0 +1,83 @@
+package input
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/text"
+)
+
+// ids is used to generate unique input ids.
+var ids int64
+
+// suggestionsMsg is the internal message for asynchronous suggestions.
+type suggestionsMsg struct {
+	id          int64
+	value       string
+	suggestions []string
+}
+
+// suggest is a command which fetches suggestions for the value.
+func suggest(m Model) tea.Cmd {
+	fn, id, value := m.Suggest, m.id, m.Value
+	return func(ctx context.Context) tea.Msg {
+		suggestions, err := fn(ctx, value)
+		if err != nil {
+			return nil
+		}
+		return suggestionsMsg{id: id, value: value, suggestions: suggestions}
+	}
+}
+
+// matches returns the suggestions which complete the value.
+func matches(m Model) (completions []string) {
+	if m.Value == "" || m.EchoMode != EchoNormal || m.searching {
+		return
+	}
+
+	suggestions := m.Suggestions
+	if m.Suggest != nil {
+		suggestions = m.suggestions
+	}
+
+	value := strings.ToLower(m.Value)
+	for _, s := range suggestions {
+		if len(s) > len(m.Value) && strings.HasPrefix(strings.ToLower(s), value) {
+			completions = append(completions, s)
+		}
+	}
+
+	return
+}
+
+// suggestion returns the active suggestion, or an empty string.
+func suggestion(m Model) string {
+	completions := matches(m)
+	if len(completions) == 0 {
+		return ""
+	}
+	return completions[mod(m.suggestion, len(completions))]
+}
+
+// ghost returns the remainder of the active suggestion,
+// which is displayed after the value.
+func ghost(m Model) string {
+	s := suggestion(m)
+	if s == "" {
+		return ""
+	}
+	n := len(text.Graphemes(m.Value))
+	return join(text.Graphemes(s)[n:])
+}
+
+// nextID returns a unique input id.
+func nextID() int64 {
+	return atomic.AddInt64(&ids, 1)
+}
+
+// mod returns the non-negative remainder of a / b.
+func mod(a, b int) int {
+	return (a%b + b) % b
+}
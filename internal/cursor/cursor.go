@@ -0,0 +1,26 @@
+// Package cursor renders the text cursor shared by the input components.
+package cursor
+
+import (
+	"strings"
+
+	"github.com/tj/go-tea/style"
+	"github.com/tj/go-tea/theme"
+)
+
+// Render s as the cursor, falling back to reverse video when colors are disabled.
+func Render(s string) string {
+	if style.CurrentProfile() == style.NoColor {
+		return style.New().Reverse().Render(s)
+	}
+	return theme.Current().Cursor.Render(s)
+}
+
+// Line renders the grapheme clusters from start to end with the cursor at pos,
+// which is rendered as a space when pos is at or beyond end.
+func Line(g []string, start, end, pos int) string {
+	if pos >= end {
+		return strings.Join(g[start:end], "") + Render(" ")
+	}
+	return strings.Join(g[start:pos], "") + Render(g[pos]) + strings.Join(g[pos+1:end], "")
+}
@@ -0,0 +1,58 @@
+// Package menu provides the items, filtering, navigation and rendering
+// shared by the option lists.
+package menu
+
+import "github.com/tj/go-tea/theme"
+
+// Item is an option, disabled option or group header.
+type Item struct {
+	// Label is the text of the option.
+	Label string
+
+	// Hint is secondary text displayed after the label, such as the
+	// reason the option is disabled.
+	Hint string
+
+	// Disabled options are displayed but cannot be selected.
+	Disabled bool
+
+	// Header is a group heading displayed above the options following
+	// it, which cannot be selected and is hidden while filtering.
+	Header bool
+
+	// Hotkey is the mnemonic of the option in lists with mnemonic
+	// hotkeys, assigned from the label when zero.
+	Hotkey rune
+}
+
+// Items returns the options as items.
+func Items(options []string) []Item {
+	items := make([]Item, len(options))
+	for i, o := range options {
+		items[i] = Item{Label: o}
+	}
+	return items
+}
+
+// Selectable returns true if the item can be selected.
+func Selectable(item Item) bool {
+	return !item.Header && !item.Disabled
+}
+
+// grouped returns true if the items contain a header.
+func grouped(items []Item) bool {
+	for _, item := range items {
+		if item.Header {
+			return true
+		}
+	}
+	return false
+}
+
+// hint renders the hint of an item.
+func hint(item Item) string {
+	if item.Hint == "" {
+		return ""
+	}
+	return " " + theme.Current().Muted.Render("("+item.Hint+")")
+}
@@ -0,0 +1,257 @@
+package menu
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/fuzzy"
+	"github.com/tj/go-tea/style"
+	"github.com/tj/go-tea/text"
+	"github.com/tj/go-tea/theme"
+	"github.com/tj/go-terminput"
+)
+
+// Model is the menu model.
+type Model struct {
+	// Items is the set of options, disabled options and headers.
+	Items []Item
+
+	// Active is the index of the active item.
+	Active int
+
+	// Height is the maximum number of lines used to display the items,
+	// or zero to display all items.
+	Height int
+
+	// Wrap moves past either end to the other instead of ringing the bell.
+	Wrap bool
+
+	// Filterable enables filtering the items by typing.
+	Filterable bool
+
+	// Filter is the text typed to filter the items.
+	Filter string
+
+	// Offset is the position of the first item displayed.
+	Offset int
+}
+
+// Update function. Up and Down move the active item, PgUp and PgDn by a
+// page, and Home and End to the ends. When filterable, typing filters the
+// items, Backspace removes a character and Escape clears the filter.
+func Update(msg tea.KeyMsg, m Model) (Model, tea.Cmd) {
+	switch msg.Key() {
+	case terminput.KeyUp:
+		return step(m, -1)
+	case terminput.KeyDown:
+		return step(m, 1)
+	case terminput.KeyPgUp:
+		return jump(m, Position(Choices(m), m.Active)-page(m))
+	case terminput.KeyPgDn:
+		return jump(m, Position(Choices(m), m.Active)+page(m))
+	case terminput.KeyHome:
+		return jump(m, 0)
+	case terminput.KeyEnd:
+		return jump(m, len(m.Items))
+	case terminput.KeyEscape:
+		if m.Filterable && m.Filter != "" {
+			m.Filter = ""
+		}
+	case terminput.KeyBackspace:
+		if !m.Filterable {
+			break
+		}
+		if m.Filter == "" {
+			return m, tea.Bell
+		}
+		g := text.Graphemes(m.Filter)
+		return SetFilter(m, strings.Join(g[:len(g)-1], "")), nil
+	case terminput.KeyRune:
+		if r := msg.Rune(); m.Filterable && !msg.Alt() && !unicode.IsControl(r) {
+			return SetFilter(m, m.Filter+string(r)), nil
+		}
+	}
+	return m, nil
+}
+
+// View function. The prefix of each option is rendered by prefix,
+// given its index and style.
+func View(m Model, prefix func(i int, s style.Style) string) string {
+	w := new(bytes.Buffer)
+	t := theme.Current()
+
+	matches := Matches(m)
+	status, _ := Status(m.Filter, len(matches))
+	w.WriteString(status)
+
+	visible := make([]int, len(matches))
+	for i, match := range matches {
+		visible[i] = match.Index
+	}
+
+	rows := rows(m, len(matches))
+	offset := scroll(Position(visible, m.Active), m.Offset, rows, len(matches))
+	if offset > 0 {
+		fmt.Fprintf(w, "  %s\n", More(t.Glyphs.MoreAbove, offset))
+	}
+
+	// indent options beneath headers
+	indent := "  "
+	if m.Filter == "" && grouped(m.Items) {
+		indent = "    "
+	}
+
+	for _, match := range matches[offset : offset+rows] {
+		item := m.Items[match.Index]
+		if item.Header {
+			fmt.Fprintf(w, "  %s\n", t.Accent.Render(item.Label))
+			continue
+		}
+
+		s := style.New()
+		switch {
+		case item.Disabled:
+			s = t.Muted
+		case match.Index == m.Active:
+			s = t.Selected
+		}
+
+		label := fuzzy.Highlight(match.Str, match.MatchedIndexes, s, t.Accent)
+		fmt.Fprintf(w, "%s%s%s%s\n", indent, prefix(match.Index, s), label, hint(item))
+	}
+
+	if n := len(matches) - offset - rows; n > 0 {
+		fmt.Fprintf(w, "  %s\n", More(t.Glyphs.MoreBelow, n))
+	}
+
+	return w.String()
+}
+
+// Normalize activates the first option which can be selected,
+// when the active item is a header or disabled.
+func Normalize(m Model) Model {
+	choices := Choices(m)
+	if len(choices) > 0 && Position(choices, m.Active) < 0 {
+		m.Active = choices[0]
+	}
+	return m
+}
+
+// SetFilter sets the filter text, activating the best match
+// when the active option no longer matches.
+func SetFilter(m Model, s string) Model {
+	m.Filter = s
+	m.Offset = 0
+	m = Normalize(m)
+	visible := Indexes(m)
+	m.Offset = scroll(Position(visible, m.Active), 0, rows(m, len(visible)), len(visible))
+	return m
+}
+
+// MoveTo activates index i, scrolling it and any header above it into view.
+func MoveTo(m Model, i int) Model {
+	visible := Indexes(m)
+	rows := rows(m, len(visible))
+	p := Position(visible, i)
+
+	m.Active = i
+	if p > 0 && m.Items[visible[p-1]].Header {
+		m.Offset = scroll(p-1, m.Offset, rows, len(visible))
+	}
+	m.Offset = scroll(p, m.Offset, rows, len(visible))
+	return m
+}
+
+// Matches returns the items matching the filter, best first,
+// with headers omitted while filtering.
+func Matches(m Model) []fuzzy.Match {
+	var labels []string
+	var index []int
+	for i, item := range m.Items {
+		if item.Header && m.Filter != "" {
+			continue
+		}
+		labels = append(labels, item.Label)
+		index = append(index, i)
+	}
+
+	matches := fuzzy.Find(m.Filter, labels)
+	for i := range matches {
+		matches[i].Index = index[matches[i].Index]
+	}
+	return matches
+}
+
+// Indexes returns the indexes of the items displayed, best first.
+func Indexes(m Model) (visible []int) {
+	for _, match := range Matches(m) {
+		visible = append(visible, match.Index)
+	}
+	return
+}
+
+// Choices returns the indexes of the items displayed which can be selected.
+func Choices(m Model) (visible []int) {
+	for _, i := range Indexes(m) {
+		if Selectable(m.Items[i]) {
+			visible = append(visible, i)
+		}
+	}
+	return
+}
+
+// step moves the active option by n options, wrapping around the ends when enabled.
+// Disabled options and headers are skipped.
+func step(m Model, n int) (Model, tea.Cmd) {
+	i, ok := Next(Choices(m), m.Active, n, m.Wrap)
+	if !ok {
+		return m, tea.Bell
+	}
+	return MoveTo(m, i), nil
+}
+
+// jump moves the active option to position p of the options which
+// can be selected, stopping at the ends.
+func jump(m Model, p int) (Model, tea.Cmd) {
+	i, ok := Seek(Choices(m), m.Active, p)
+	if !ok {
+		return m, tea.Bell
+	}
+	return MoveTo(m, i), nil
+}
+
+// page returns the number of options moved by a page.
+func page(m Model) int {
+	return rows(m, len(Indexes(m)))
+}
+
+// rows returns the number of options displayed of n, within the height
+// remaining beneath the filter, reserving a line for each indicator when
+// the options are scrolled.
+func rows(m Model, n int) int {
+	_, status := Status(m.Filter, n)
+	height := m.Height - status
+	if m.Height <= 0 || n <= height {
+		return n
+	}
+	return Max(1, height-2)
+}
+
+// scroll returns the position of the first option displayed,
+// keeping position p in view with rows options displayed of n.
+func scroll(p, offset, rows, n int) int {
+	offset = Clamp(offset, 0, n-rows)
+	if p < 0 {
+		return offset
+	}
+	if p < offset {
+		return p
+	}
+	if p >= offset+rows {
+		return p - rows + 1
+	}
+	return offset
+}
@@ -0,0 +1,93 @@
+package menu
+
+import (
+	"fmt"
+
+	"github.com/tj/go-tea/theme"
+)
+
+// Next returns the index n positions from index i in the visible indexes,
+// wrapping around the ends when enabled, or false when there is none.
+// The first index is returned when i is not visible.
+func Next(visible []int, i, n int, wrap bool) (int, bool) {
+	p := Position(visible, i)
+	j := p + n
+
+	switch {
+	case len(visible) == 0:
+		return 0, false
+	case p < 0:
+		j = 0
+	case j >= 0 && j < len(visible):
+	case wrap:
+		j = (j%len(visible) + len(visible)) % len(visible)
+	default:
+		return 0, false
+	}
+
+	return visible[j], true
+}
+
+// Seek returns the index at position p of the visible indexes, stopping at
+// the ends, or false when there is none or it is already index i.
+func Seek(visible []int, i, p int) (int, bool) {
+	if len(visible) == 0 {
+		return 0, false
+	}
+
+	p = Clamp(p, 0, len(visible)-1)
+	if visible[p] == i {
+		return 0, false
+	}
+
+	return visible[p], true
+}
+
+// Position returns the position of index i in the visible indexes, or -1.
+func Position(visible []int, i int) int {
+	for p, v := range visible {
+		if v == i {
+			return p
+		}
+	}
+	return -1
+}
+
+// Status renders the filter and, when nothing matches, a "No matches"
+// line, returning the number of lines rendered.
+func Status(filter string, matches int) (string, int) {
+	if filter == "" {
+		return "", 0
+	}
+
+	t := theme.Current()
+	s := fmt.Sprintf("  %s\n", t.Muted.Render("/ "+filter))
+	if matches == 0 {
+		return s + fmt.Sprintf("  %s\n", t.Muted.Render("No matches")), 2
+	}
+	return s, 1
+}
+
+// More renders an indicator of n items scrolled out of view.
+func More(glyph string, n int) string {
+	return theme.Current().Muted.Render(fmt.Sprintf("%s %d more", glyph, n))
+}
+
+// Clamp n between min and max.
+func Clamp(n, min, max int) int {
+	if n > max {
+		n = max
+	}
+	if n < min {
+		n = min
+	}
+	return n
+}
+
+// Max returns the maximum of two ints.
+func Max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,162 @@
+// Package number provides the model shared by the numeric inputs, which
+// supply the parsing and formatting of their type.
+package number
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/input"
+	"github.com/tj/go-tea/key"
+)
+
+// Type is a numeric type.
+type Type struct {
+	// Parse a value from non-empty text, returning an error describing the expected format.
+	Parse func(s string) (Value, error)
+
+	// Filter returns true for the characters of a value.
+	Filter func(r rune) bool
+
+	// Zero is the zero value.
+	Zero Value
+}
+
+// Model is the numeric input model.
+type Model struct {
+	// Type of the value.
+	Type Type
+
+	// Input is the text input.
+	Input input.Model
+
+	// Min is the minimum value, or nil when unbounded.
+	Min Value
+
+	// Max is the maximum value, or nil when unbounded.
+	Max Value
+
+	// Step is the amount added or subtracted by Up and Down.
+	Step Value
+
+	// Err is the current parse or bounds error.
+	Err error
+}
+
+// Value returns the value, the zero value clamped to the bounds when empty,
+// or the zero value when invalid.
+func (m *Model) Value() Value {
+	v, err := parse(*m)
+	if err != nil {
+		return m.Type.Zero
+	}
+	return v
+}
+
+// SetValue sets the value.
+func (m *Model) SetValue(v Value) {
+	m.Input.SetValue(v.String())
+	m.Err = validate(*m)
+}
+
+// Valid validates the current value, returning true if it is valid.
+// Unlike while editing, an empty value is invalid.
+func (m *Model) Valid() bool {
+	m.Err = validate(*m)
+	if m.Err == nil && empty(*m) {
+		m.Err = errors.New("value is required")
+	}
+	return m.Err == nil
+}
+
+// Update function.
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, "up"):
+		return increment(m, m.Step)
+	case key.Matches(msg, "down"):
+		return increment(m, m.Step.Neg())
+	}
+
+	var cmd tea.Cmd
+	m.Input.Filter = m.Type.Filter
+	m.Input, cmd = input.Update(msg, m.Input)
+	m.Err = validate(m)
+	return m, cmd
+}
+
+// View function.
+func View(m Model) string {
+	m.Input.Err = m.Err
+	return input.View(m.Input)
+}
+
+// increment the value by n, clamped to the bounds.
+// An empty value is set to its initial value.
+func increment(m Model, n Value) (Model, tea.Cmd) {
+	v, err := parse(m)
+	if err != nil {
+		return m, tea.Bell
+	}
+
+	if empty(m) {
+		m.SetValue(v)
+		return m, nil
+	}
+
+	next := clamp(m, v.Add(n))
+	if next.Cmp(v) == 0 {
+		return m, tea.Bell
+	}
+
+	m.SetValue(next)
+	return m, nil
+}
+
+// parse returns the value, where an empty value is
+// the zero value clamped to the bounds.
+func parse(m Model) (Value, error) {
+	if empty(m) {
+		return clamp(m, m.Type.Zero), nil
+	}
+	return m.Type.Parse(strings.TrimSpace(m.Input.Value))
+}
+
+// validate returns an error if the value is invalid or out of bounds.
+func validate(m Model) error {
+	if empty(m) {
+		return nil
+	}
+
+	v, err := parse(m)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case m.Min != nil && v.Cmp(m.Min) < 0:
+		return fmt.Errorf("must be at least %s", m.Min)
+	case m.Max != nil && v.Cmp(m.Max) > 0:
+		return fmt.Errorf("must be at most %s", m.Max)
+	default:
+		return nil
+	}
+}
+
+// clamp v to the bounds.
+func clamp(m Model, v Value) Value {
+	if m.Min != nil && v.Cmp(m.Min) < 0 {
+		return m.Min
+	}
+	if m.Max != nil && v.Cmp(m.Max) > 0 {
+		return m.Max
+	}
+	return v
+}
+
+// empty returns true if no value has been entered.
+func empty(m Model) bool {
+	return strings.TrimSpace(m.Input.Value) == ""
+}
@@ -0,0 +1,172 @@
+package number
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Value is a value of a numeric type.
+type Value interface {
+	// Cmp returns -1, 0 or 1 when the value is less than,
+	// equal to or greater than v, which must be of the same type.
+	Cmp(v Value) int
+
+	// Add returns the value plus v, saturating at the limits of the type.
+	Add(v Value) Value
+
+	// Neg returns the negated value.
+	Neg() Value
+
+	// String formats the value.
+	String() string
+}
+
+// intValue is an integer value.
+type intValue struct {
+	n      int64
+	format func(int64) string
+}
+
+// Int returns an integer value, formatted by format.
+func Int(n int64, format func(int64) string) Value {
+	return intValue{n: n, format: format}
+}
+
+// AsInt returns the integer of a value returned by Int.
+func AsInt(v Value) int64 {
+	return v.(intValue).n
+}
+
+// Cmp implementation.
+func (v intValue) Cmp(o Value) int {
+	n := AsInt(o)
+	switch {
+	case v.n < n:
+		return -1
+	case v.n > n:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Add implementation.
+func (v intValue) Add(o Value) Value {
+	n := AsInt(o)
+	switch {
+	case n > 0 && v.n > math.MaxInt64-n:
+		v.n = math.MaxInt64
+	case n < 0 && v.n < math.MinInt64-n:
+		v.n = math.MinInt64
+	default:
+		v.n += n
+	}
+	return v
+}
+
+// Neg implementation.
+func (v intValue) Neg() Value {
+	if v.n == math.MinInt64 {
+		v.n = math.MaxInt64
+	} else {
+		v.n = -v.n
+	}
+	return v
+}
+
+// String implementation.
+func (v intValue) String() string {
+	return v.format(v.n)
+}
+
+// floatValue is a floating point value.
+type floatValue struct {
+	n         float64
+	precision int
+}
+
+// Float returns a floating point value. Values added to it are rounded
+// to the given number of decimal places, or when zero, the decimal places
+// of either value, whichever is greater.
+func Float(n float64, precision int) Value {
+	return floatValue{n: n, precision: precision}
+}
+
+// AsFloat returns the number of a value returned by Float.
+func AsFloat(v Value) float64 {
+	return v.(floatValue).n
+}
+
+// Cmp implementation.
+func (v floatValue) Cmp(o Value) int {
+	n := AsFloat(o)
+	switch {
+	case v.n < n:
+		return -1
+	case v.n > n:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Add implementation.
+func (v floatValue) Add(o Value) Value {
+	f := o.(floatValue)
+
+	p := v.precision
+	if f.precision > p {
+		p = f.precision
+	}
+	if p == 0 {
+		p = decimals(v.n)
+		if d := decimals(f.n); d > p {
+			p = d
+		}
+	}
+
+	n := round(v.n+f.n, p)
+	switch {
+	case math.IsInf(n, 1):
+		n = math.MaxFloat64
+	case math.IsInf(n, -1):
+		n = -math.MaxFloat64
+	}
+
+	return floatValue{n: n, precision: p}
+}
+
+// Neg implementation.
+func (v floatValue) Neg() Value {
+	v.n = -v.n
+	return v
+}
+
+// String implementation.
+func (v floatValue) String() string {
+	if v.precision > 0 {
+		return strconv.FormatFloat(v.n, 'f', v.precision, 64)
+	}
+	return strconv.FormatFloat(v.n, 'f', -1, 64)
+}
+
+// decimals returns the number of decimal places of n.
+func decimals(n float64) int {
+	s := strconv.FormatFloat(n, 'f', -1, 64)
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		return len(s) - i - 1
+	}
+	return 0
+}
+
+// round n to the given number of decimal places, or
+// unrounded when too large to scale.
+func round(n float64, decimals int) float64 {
+	p := math.Pow(10, float64(decimals))
+	r := math.Round(n*p) / p
+	if math.IsInf(r, 0) || math.IsNaN(r) {
+		return n
+	}
+	return r
+}
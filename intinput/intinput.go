@@ -0,0 +1,127 @@
+// Package intinput provides an integer input.
+package intinput
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/input"
+	"github.com/tj/go-tea/internal/number"
+)
+
+// Model is the integer input model.
+type Model struct {
+	// Input is the text input, which may be used to set the prompt,
+	// placeholder or a unit suffix such as " items". Its Filter is
+	// replaced to allow only the characters of an integer.
+	Input input.Model
+
+	// Min is the minimum value, enforced when HasMin is set.
+	Min int64
+
+	// HasMin enables the minimum, which may be zero.
+	HasMin bool
+
+	// Max is the maximum value, enforced when HasMax is set.
+	Max int64
+
+	// HasMax enables the maximum, which may be zero.
+	HasMax bool
+
+	// Step is the amount added or subtracted by Up and Down. Defaults to 1.
+	Step int64
+
+	// Err is the current parse or bounds error, which is rendered beneath the input.
+	Err error
+}
+
+// Value returns the value, the minimum when empty, or 0 when invalid.
+func (m *Model) Value() int64 {
+	n := m.number()
+	return number.AsInt(n.Value())
+}
+
+// SetValue sets the value.
+func (m *Model) SetValue(v int64) {
+	n := m.number()
+	n.SetValue(value(v))
+	m.Input, m.Err = n.Input, n.Err
+}
+
+// Valid validates the current value, returning true if it is valid.
+// Unlike while editing, an empty value is invalid.
+func (m *Model) Valid() bool {
+	n := m.number()
+	ok := n.Valid()
+	m.Err = n.Err
+	return ok
+}
+
+// Update function.
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	n, cmd := number.Update(msg, m.number())
+	m.Input, m.Err = n.Input, n.Err
+	return m, cmd
+}
+
+// View function.
+func View(m Model) string {
+	return number.View(m.number())
+}
+
+// number returns the numeric input model.
+func (m *Model) number() number.Model {
+	step := m.Step
+	if step == 0 {
+		step = 1
+	}
+
+	return number.Model{
+		Type:  integer,
+		Input: m.Input,
+		Min:   bound(m.Min, m.HasMin),
+		Max:   bound(m.Max, m.HasMax),
+		Step:  value(step),
+		Err:   m.Err,
+	}
+}
+
+// integer is the integer type.
+var integer = number.Type{
+	Parse:  parse,
+	Filter: filter,
+	Zero:   value(0),
+}
+
+// value returns n as a value.
+func value(n int64) number.Value {
+	return number.Int(n, format)
+}
+
+// bound returns n as a bound, or nil when unset.
+func bound(n int64, ok bool) number.Value {
+	if !ok {
+		return nil
+	}
+	return value(n)
+}
+
+// parse an integer.
+func parse(s string) (number.Value, error) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, errors.New("must be a whole number")
+	}
+	return value(n), nil
+}
+
+// format an integer.
+func format(n int64) string {
+	return strconv.FormatInt(n, 10)
+}
+
+// filter returns true for the characters of an integer.
+func filter(r rune) bool {
+	return r >= '0' && r <= '9' || r == '-' || r == '+'
+}
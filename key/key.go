@@ -0,0 +1,83 @@
+// Package key provides human-readable key names for keyboard input.
+package key
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-terminput"
+)
+
+// keyNames is a map of special keys to their names.
+var keyNames = map[terminput.Key]string{
+	terminput.KeyNUL:       "ctrl+@",
+	terminput.KeyBackspace: "backspace",
+	terminput.KeyTab:       "tab",
+	terminput.KeyLF:        "ctrl+j",
+	terminput.KeyEnter:     "enter",
+	terminput.KeyEscape:    "esc",
+	terminput.KeyFS:        "ctrl+\\",
+	terminput.KeyGS:        "ctrl+]",
+	terminput.KeyRS:        "ctrl+^",
+	terminput.KeyUS:        "ctrl+_",
+	terminput.KeyUp:        "up",
+	terminput.KeyDown:      "down",
+	terminput.KeyRight:     "right",
+	terminput.KeyLeft:      "left",
+	terminput.KeyInsert:    "insert",
+	terminput.KeyBacktab:   "shift+tab",
+	terminput.KeyDelete:    "delete",
+	terminput.KeyHome:      "home",
+	terminput.KeyEnd:       "end",
+	terminput.KeyPgUp:      "pgup",
+	terminput.KeyPgDn:      "pgdown",
+}
+
+// Name returns the name of a key such as "g", "G", "space",
+// "ctrl+x", "alt+left", "alt+d", "shift+up" or "enter".
+func Name(k tea.KeyMsg) string {
+	var s string
+
+	switch key := k.Key(); {
+	case key == terminput.KeyRune && k.Rune() == ' ':
+		s = "space"
+	case key == terminput.KeyRune:
+		s = string(k.Rune())
+	case key >= terminput.KeyF1 && key <= terminput.KeyF20:
+		s = "f" + strconv.Itoa(int(key-terminput.KeyF1)+1)
+	case keyNames[key] != "":
+		s = keyNames[key]
+	case key > terminput.KeyNUL && key < terminput.KeyESC:
+		s = "ctrl+" + string('a'+rune(key)-1)
+	default:
+		s = strings.ToLower(k.String())
+	}
+
+	if k.Shift() {
+		s = "shift+" + s
+	}
+	if k.Alt() {
+		s = "alt+" + s
+	}
+	if k.Ctrl() && !strings.HasPrefix(s, "ctrl+") {
+		s = "ctrl+" + s
+	}
+
+	return s
+}
+
+// Matches returns true if msg is keyboard input matching one of the given key names.
+func Matches(msg tea.Msg, names ...string) bool {
+	k, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return false
+	}
+	name := Name(k)
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
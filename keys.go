@@ -0,0 +1,204 @@
+package tea
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+
+	"github.com/tj/go-terminput"
+)
+
+// csiModified matches CSI key sequences with optional parameters, such as
+// "ESC [ 1 ; 2 A" for Shift-Up or "ESC [ 3 ; 3 ~" for Alt-Delete.
+var csiModified = regexp.MustCompile(`^\x1b\[(\d*)(?:;(\d+))?([~A-Z])$`)
+
+// ss3 matches SS3 key sequences sent in application cursor mode, such
+// as "ESC O A" for Up, with an optional modifier such as "ESC O 2 P".
+var ss3 = regexp.MustCompile(`^\x1bO(\d*)([A-Z])$`)
+
+// shiftArrows is the Shift+arrow sequences of rxvt.
+var shiftArrows = map[string]string{
+	"\x1b[a": "\x1b[A",
+	"\x1b[b": "\x1b[B",
+	"\x1b[c": "\x1b[C",
+	"\x1b[d": "\x1b[D",
+}
+
+// KeyMsg is a key press. Keys terminput decodes are sent as its
+// *terminput.KeyboardInput, while keys with modifiers it does not
+// decode, such as Alt-D or Shift-Up, are sent as a KeyMsg of their own.
+type KeyMsg interface {
+	// Key returns the key pressed, or KeyRune for a literal rune.
+	Key() terminput.Key
+
+	// Rune returns the rune pressed, defined only when Key is KeyRune.
+	Rune() rune
+
+	// Mod returns the modifier flags.
+	Mod() terminput.Mod
+
+	// Ctrl returns true if ctrl was pressed.
+	Ctrl() bool
+
+	// Alt returns true if alt was pressed.
+	Alt() bool
+
+	// Meta returns true if meta was pressed.
+	Meta() bool
+
+	// Shift returns true if shift was pressed.
+	Shift() bool
+
+	// String returns a human-friendly name of the key.
+	String() string
+}
+
+// parseKey returns the key for input b. Terminput only decodes modifiers
+// for a few sequences, so sequences it does not recognize are decoded here,
+// including keys prefixed with ESC by terminals sending Alt that way.
+func parseKey(b []byte) (KeyMsg, error) {
+	k, err := terminput.Read(bytes.NewReader(b))
+	if err != nil || !unknown(k, b) {
+		return k, err
+	}
+
+	// rxvt Shift+arrows
+	if seq, ok := shiftArrows[string(b)]; ok {
+		k, err := terminput.Read(bytes.NewReader([]byte(seq)))
+		if err != nil {
+			return nil, err
+		}
+		return withMod(k, terminput.ModShift), nil
+	}
+
+	// CSI sequences with modifiers
+	if m := csiModified.FindSubmatch(b); m != nil {
+		if base := csiBase(m[1], m[3]); base != nil {
+			return withMod(base, csiMod(m[2])), nil
+		}
+		return k, nil
+	}
+
+	// SS3 sequences
+	if m := ss3.FindSubmatch(b); m != nil {
+		if base := csiBase(nil, m[2]); base != nil {
+			return withMod(base, csiMod(m[1])), nil
+		}
+	}
+
+	// ESC prefixed keys
+	rest, err := parseKey(b[1:])
+	if err != nil {
+		return k, nil
+	}
+
+	return withMod(rest, terminput.ModAlt), nil
+}
+
+// unknown returns true if k is an escape sequence terminput did not recognize,
+// which it returns as the ESC rune.
+func unknown(k *terminput.KeyboardInput, b []byte) bool {
+	return len(b) > 1 && k.Key() == terminput.KeyRune && k.Rune() == '\x1b'
+}
+
+// csiBase returns the key of a CSI sequence without its modifiers, or nil.
+func csiBase(param, final []byte) *terminput.KeyboardInput {
+	var seqs []string
+	switch {
+	case final[0] == '~':
+		seqs = []string{"\x1b[" + string(param) + "~"}
+	default:
+		seqs = []string{"\x1b[" + string(final), "\x1bO" + string(final)}
+	}
+
+	for _, seq := range seqs {
+		k, err := terminput.Read(bytes.NewReader([]byte(seq)))
+		if err == nil && !unknown(k, []byte(seq)) {
+			return k
+		}
+	}
+
+	return nil
+}
+
+// csiMod returns the modifiers of a CSI modifier parameter,
+// which is one plus a bitmask of shift, alt, ctrl and meta.
+func csiMod(param []byte) terminput.Mod {
+	n, err := strconv.Atoi(string(param))
+	if err != nil || n < 1 {
+		return 0
+	}
+
+	var mod terminput.Mod
+	bits := n - 1
+	if bits&1 != 0 {
+		mod |= terminput.ModShift
+	}
+	if bits&2 != 0 {
+		mod |= terminput.ModAlt
+	}
+	if bits&4 != 0 {
+		mod |= terminput.ModCtrl
+	}
+	if bits&8 != 0 {
+		mod |= terminput.ModMeta
+	}
+	return mod
+}
+
+// modified is a key press with modifiers added to those decoded by terminput.
+type modified struct {
+	*terminput.KeyboardInput
+	mod terminput.Mod
+}
+
+// withMod returns k with the given modifiers added.
+func withMod(k KeyMsg, mod terminput.Mod) KeyMsg {
+	if m, ok := k.(*modified); ok {
+		return &modified{KeyboardInput: m.KeyboardInput, mod: m.mod | mod}
+	}
+	return &modified{KeyboardInput: k.(*terminput.KeyboardInput), mod: mod}
+}
+
+// Mod implementation.
+func (k *modified) Mod() terminput.Mod {
+	return k.KeyboardInput.Mod() | k.mod
+}
+
+// Ctrl implementation.
+func (k *modified) Ctrl() bool {
+	return k.Mod()&terminput.ModCtrl != 0
+}
+
+// Alt implementation.
+func (k *modified) Alt() bool {
+	return k.Mod()&terminput.ModAlt != 0
+}
+
+// Meta implementation.
+func (k *modified) Meta() bool {
+	return k.Mod()&terminput.ModMeta != 0
+}
+
+// Shift implementation.
+func (k *modified) Shift() bool {
+	return k.Mod()&terminput.ModShift != 0
+}
+
+// String implementation.
+func (k *modified) String() string {
+	s := k.KeyboardInput.String()
+	if k.Shift() && !k.KeyboardInput.Shift() {
+		s = "Shift+" + s
+	}
+	if k.Alt() && !k.KeyboardInput.Alt() {
+		s = "Alt+" + s
+	}
+	if k.Ctrl() && !k.KeyboardInput.Ctrl() {
+		s = "Ctrl+" + s
+	}
+	if k.Meta() && !k.KeyboardInput.Meta() {
+		s = "Meta+" + s
+	}
+	return s
+}
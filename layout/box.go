@@ -0,0 +1,166 @@
+package layout
+
+import (
+	"strings"
+
+	"github.com/tj/go-tea/style"
+	"github.com/tj/go-tea/text"
+)
+
+// Border is a set of glyphs used to draw a box border.
+type Border struct {
+	Top         string
+	Bottom      string
+	Left        string
+	Right       string
+	TopLeft     string
+	TopRight    string
+	BottomLeft  string
+	BottomRight string
+}
+
+// Borders available.
+var (
+	NormalBorder  = Border{"─", "─", "│", "│", "┌", "┐", "└", "┘"}
+	RoundedBorder = Border{"─", "─", "│", "│", "╭", "╮", "╰", "╯"}
+	ThickBorder   = Border{"━", "━", "┃", "┃", "┏", "┓", "┗", "┛"}
+	DoubleBorder  = Border{"═", "═", "║", "║", "╔", "╗", "╚", "╝"}
+	ASCIIBorder   = Border{"-", "-", "|", "|", "+", "+", "+", "+"}
+)
+
+// Spacing is the space around each side of a box.
+type Spacing struct {
+	Top    int
+	Right  int
+	Bottom int
+	Left   int
+}
+
+// Box renders a block of text with padding, borders and margins.
+type Box struct {
+	// Width is the fixed width of the box including its padding and border,
+	// content which does not fit is clipped. Defaults to the content width.
+	Width int
+
+	// Height is the fixed height of the box including its padding and border,
+	// content which does not fit is clipped. Defaults to the content height.
+	Height int
+
+	// Align is the horizontal alignment of the content.
+	Align Position
+
+	// VerticalAlign is the vertical alignment of the content.
+	VerticalAlign Position
+
+	// Padding is the space between the content and the border.
+	Padding Spacing
+
+	// Margin is the space outside of the border.
+	Margin Spacing
+
+	// Border is the border drawn, the zero value draws no border.
+	Border Border
+
+	// BorderStyle is the style applied to the border.
+	BorderStyle style.Style
+}
+
+// Render the box with the given content.
+func (b Box) Render(s string) string {
+	lines := strings.Split(s, "\n")
+	bl, br := text.Width(b.Border.Left), text.Width(b.Border.Right)
+	bt, bb := 0, 0
+	if b.Border.Top != "" {
+		bt = 1
+	}
+	if b.Border.Bottom != "" {
+		bb = 1
+	}
+
+	// content size
+	w := Width(s)
+	if b.Width > 0 {
+		w = max(0, b.Width-b.Padding.Left-b.Padding.Right-bl-br)
+	}
+
+	h := len(lines)
+	if b.Height > 0 {
+		h = max(0, b.Height-b.Padding.Top-b.Padding.Bottom-bt-bb)
+	}
+
+	// content
+	lines = alignLines(lines, h, b.VerticalAlign)
+	for i, line := range lines {
+		lines[i] = align(line, w, b.Align)
+	}
+
+	// padding
+	lines = space(lines, w, b.Padding)
+	w += b.Padding.Left + b.Padding.Right
+
+	// border
+	if b.Border != (Border{}) {
+		lines = border(lines, w, b.Border, b.BorderStyle)
+		w += bl + br
+	}
+
+	// margin
+	lines = space(lines, w, b.Margin)
+
+	return strings.Join(lines, "\n")
+}
+
+// space surrounds lines of the given width with whitespace.
+func space(lines []string, w int, s Spacing) []string {
+	left := strings.Repeat(" ", s.Left)
+	right := strings.Repeat(" ", s.Right)
+	empty := strings.Repeat(" ", s.Left+w+s.Right)
+
+	var out []string
+	for i := 0; i < s.Top; i++ {
+		out = append(out, empty)
+	}
+	for _, line := range lines {
+		out = append(out, left+line+right)
+	}
+	for i := 0; i < s.Bottom; i++ {
+		out = append(out, empty)
+	}
+	return out
+}
+
+// border surrounds lines of the given width with a border.
+func border(lines []string, w int, b Border, s style.Style) []string {
+	var out []string
+
+	if b.Top != "" {
+		out = append(out, s.Render(b.TopLeft+repeat(b.Top, w)+b.TopRight))
+	}
+
+	for _, line := range lines {
+		out = append(out, s.Render(b.Left)+line+s.Render(b.Right))
+	}
+
+	if b.Bottom != "" {
+		out = append(out, s.Render(b.BottomLeft+repeat(b.Bottom, w)+b.BottomRight))
+	}
+
+	return out
+}
+
+// repeat s to fill the given width.
+func repeat(s string, w int) string {
+	n := text.Width(s)
+	if n == 0 {
+		return strings.Repeat(" ", w)
+	}
+	return text.Truncate(strings.Repeat(s, (w+n-1)/n), w, "")
+}
+
+// max returns the maximum of two ints.
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
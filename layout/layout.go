@@ -0,0 +1,129 @@
+// Package layout provides joining, alignment, padding and borders for blocks of text.
+package layout
+
+import (
+	"strings"
+
+	"github.com/tj/go-tea/text"
+)
+
+// Position is the alignment of a block within an area.
+type Position int
+
+// Positions available.
+const (
+	Top Position = iota
+	Center
+	Bottom
+)
+
+// Horizontal aliases.
+const (
+	Left  = Top
+	Right = Bottom
+)
+
+// JoinHorizontal joins blocks side by side, aligning blocks of
+// different heights vertically to the given position.
+func JoinHorizontal(pos Position, blocks ...string) string {
+	if len(blocks) == 0 {
+		return ""
+	}
+
+	height := 0
+	for _, b := range blocks {
+		if h := Height(b); h > height {
+			height = h
+		}
+	}
+
+	rows := make([]string, height)
+	for _, b := range blocks {
+		w := Width(b)
+		lines := alignLines(strings.Split(b, "\n"), height, pos)
+		for i, line := range lines {
+			rows[i] += text.PadRight(line, w)
+		}
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// JoinVertical joins blocks one above the other, aligning blocks of
+// different widths horizontally to the given position.
+func JoinVertical(pos Position, blocks ...string) string {
+	if len(blocks) == 0 {
+		return ""
+	}
+
+	width := 0
+	for _, b := range blocks {
+		if w := Width(b); w > width {
+			width = w
+		}
+	}
+
+	var rows []string
+	for _, b := range blocks {
+		for _, line := range strings.Split(b, "\n") {
+			rows = append(rows, align(line, width, pos))
+		}
+	}
+
+	return strings.Join(rows, "\n")
+}
+
+// Width returns the width of the widest line in a block.
+func Width(s string) int {
+	return text.Width(s)
+}
+
+// Height returns the number of lines in a block.
+func Height(s string) int {
+	return strings.Count(s, "\n") + 1
+}
+
+// align a line horizontally within the given width, truncating it when too wide.
+func align(s string, w int, pos Position) string {
+	n := text.Width(s)
+
+	if n > w {
+		return text.Truncate(s, w, "")
+	}
+
+	gap := w - n
+	switch pos {
+	case Center:
+		return strings.Repeat(" ", gap/2) + s + strings.Repeat(" ", gap-gap/2)
+	case Right:
+		return strings.Repeat(" ", gap) + s
+	default:
+		return s + strings.Repeat(" ", gap)
+	}
+}
+
+// alignLines aligns lines vertically within the given height, clipping them when too tall.
+func alignLines(lines []string, h int, pos Position) []string {
+	if len(lines) > h {
+		return lines[:h]
+	}
+
+	gap := h - len(lines)
+	var before int
+	switch pos {
+	case Center:
+		before = gap / 2
+	case Bottom:
+		before = gap
+	}
+
+	out := make([]string, 0, h)
+	for i := 0; i < before; i++ {
+		out = append(out, "")
+	}
+	out = append(out, lines...)
+	for len(out) < h {
+		out = append(out, "")
+	}
+	return out
+}
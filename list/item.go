@@ -0,0 +1,102 @@
+package list
+
+import (
+	"strings"
+
+	"github.com/tj/go-tea/style"
+	"github.com/tj/go-tea/theme"
+)
+
+// Item is an item in a list.
+type Item interface {
+	// FilterValue is the text matched against the filter.
+	FilterValue() string
+}
+
+// DefaultItem is an item with a title and description,
+// which is rendered by the DefaultDelegate.
+type DefaultItem interface {
+	Item
+
+	// Title is the text of the item.
+	Title() string
+
+	// Description is the secondary text displayed beneath the title.
+	Description() string
+}
+
+// StyledItem is an item with its own style, which the DefaultDelegate
+// uses to render the title when the item is not active.
+type StyledItem interface {
+	Item
+
+	// Style of the item.
+	Style() style.Style
+}
+
+// ItemDelegate renders items, allowing any item type to be displayed.
+type ItemDelegate interface {
+	// Height returns the number of lines rendered for the item.
+	Height(item Item) int
+
+	// Render the item at the given index, where active is
+	// true for the active item. The result must have the
+	// number of lines returned by Height.
+	Render(item Item, index int, active bool) string
+}
+
+// DefaultDelegate renders the title and description of DefaultItems, and
+// the FilterValue of other items. The active item uses the Selected style.
+type DefaultDelegate struct {
+	// HideDescription hides the description of items.
+	HideDescription bool
+}
+
+// Height implementation.
+func (d DefaultDelegate) Height(item Item) int {
+	if d.description(item) != "" {
+		return 2
+	}
+	return 1
+}
+
+// Render implementation.
+func (d DefaultDelegate) Render(item Item, index int, active bool) string {
+	t := theme.Current()
+
+	title := item.FilterValue()
+	if v, ok := item.(DefaultItem); ok {
+		title = v.Title()
+	}
+
+	s := style.New()
+	if v, ok := item.(StyledItem); ok {
+		s = v.Style()
+	}
+	if active {
+		s = t.Selected
+	}
+
+	title = "  " + s.Render(firstLine(title))
+	if desc := d.description(item); desc != "" {
+		return title + "\n  " + t.Muted.Render(desc)
+	}
+
+	return title
+}
+
+// description returns the single line description of the item, if displayed.
+func (d DefaultDelegate) description(item Item) string {
+	if v, ok := item.(DefaultItem); ok && !d.HideDescription {
+		return firstLine(v.Description())
+	}
+	return ""
+}
+
+// firstLine returns the first line of s.
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
@@ -0,0 +1,303 @@
+// Package list provides a list of arbitrary items, rendered by a delegate.
+package list
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/fuzzy"
+	"github.com/tj/go-tea/internal/menu"
+	"github.com/tj/go-tea/text"
+	"github.com/tj/go-tea/theme"
+	"github.com/tj/go-terminput"
+)
+
+// Model is the list model.
+type Model struct {
+	// Items is the set of items.
+	Items []Item
+
+	// Delegate renders the items. Defaults to DefaultDelegate.
+	Delegate ItemDelegate
+
+	// Selected is the index of the active item.
+	Selected int
+
+	// Height is the maximum number of lines displayed, counting each item
+	// by the height of its delegate. Defaults to displaying all items.
+	Height int
+
+	// Wrap moves from either end of the list to the other.
+	Wrap bool
+
+	// Filterable enables filtering the items by typing,
+	// fuzzy matching their FilterValue.
+	Filterable bool
+
+	// filter is the text typed to filter the items.
+	filter string
+
+	// offset is the position of the first item displayed.
+	offset int
+}
+
+// SelectedItem returns the active item, or nil when there are no items.
+func (m *Model) SelectedItem() Item {
+	if m.Selected < 0 || m.Selected >= len(m.Items) {
+		return nil
+	}
+	return m.Items[m.Selected]
+}
+
+// InsertItem inserts an item at index i, keeping the active item selected.
+func (m *Model) InsertItem(i int, item Item) {
+	i = menu.Clamp(i, 0, len(m.Items))
+
+	items := make([]Item, 0, len(m.Items)+1)
+	items = append(items, m.Items[:i]...)
+	items = append(items, item)
+	items = append(items, m.Items[i:]...)
+	m.Items = items
+
+	if i <= m.Selected && len(m.Items) > 1 {
+		m.Selected++
+	}
+}
+
+// RemoveItem removes the item at index i. When the active item is removed
+// the following item becomes active, or the previous item at the end.
+func (m *Model) RemoveItem(i int) {
+	if i < 0 || i >= len(m.Items) {
+		return
+	}
+
+	items := make([]Item, 0, len(m.Items)-1)
+	items = append(items, m.Items[:i]...)
+	items = append(items, m.Items[i+1:]...)
+	m.Items = items
+
+	if i < m.Selected {
+		m.Selected--
+	}
+	m.Selected = menu.Clamp(m.Selected, 0, menu.Max(0, len(m.Items)-1))
+}
+
+// Filter returns the text typed to filter the items.
+func (m *Model) Filter() string {
+	return m.filter
+}
+
+// Filtering returns true if the items are filtered.
+func (m *Model) Filtering() bool {
+	return m.filter != ""
+}
+
+// Update function.
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.Key() {
+		case terminput.KeyUp:
+			return step(m, -1)
+		case terminput.KeyDown:
+			return step(m, 1)
+		case terminput.KeyPgUp:
+			return jump(m, menu.Position(indexes(m), m.Selected)-page(m))
+		case terminput.KeyPgDn:
+			return jump(m, menu.Position(indexes(m), m.Selected)+page(m))
+		case terminput.KeyHome:
+			return jump(m, 0)
+		case terminput.KeyEnd:
+			return jump(m, len(m.Items))
+		case terminput.KeyEscape:
+			if m.Filterable && m.filter != "" {
+				m.filter = ""
+			}
+		case terminput.KeyBackspace:
+			if !m.Filterable {
+				break
+			}
+			if m.filter == "" {
+				return m, tea.Bell
+			}
+			g := text.Graphemes(m.filter)
+			return filter(m, strings.Join(g[:len(g)-1], "")), nil
+		case terminput.KeyRune:
+			if r := msg.Rune(); m.Filterable && !msg.Alt() && !unicode.IsControl(r) {
+				return filter(m, m.filter+string(r)), nil
+			}
+		}
+	}
+	return m, nil
+}
+
+// View function.
+func View(m Model) string {
+	w := new(bytes.Buffer)
+	t := theme.Current()
+	d := delegate(m)
+
+	visible := indexes(m)
+	status, _ := menu.Status(m.filter, len(visible))
+	w.WriteString(status)
+
+	from, to := window(m, visible)
+	if from > 0 {
+		fmt.Fprintf(w, "  %s\n", menu.More(t.Glyphs.MoreAbove, from))
+	}
+
+	for _, i := range visible[from:to] {
+		fmt.Fprintf(w, "%s\n", d.Render(m.Items[i], i, i == m.Selected))
+	}
+
+	if n := len(visible) - to; n > 0 {
+		fmt.Fprintf(w, "  %s\n", menu.More(t.Glyphs.MoreBelow, n))
+	}
+
+	return w.String()
+}
+
+// delegate returns the item delegate.
+func delegate(m Model) ItemDelegate {
+	if m.Delegate == nil {
+		return DefaultDelegate{}
+	}
+	return m.Delegate
+}
+
+// filter sets the filter text, selecting the best match
+// when the active item no longer matches.
+func filter(m Model, s string) Model {
+	m.filter = s
+	m.offset = 0
+	visible := indexes(m)
+	if len(visible) > 0 && menu.Position(visible, m.Selected) < 0 {
+		m.Selected = visible[0]
+	}
+	m.offset, _ = window(m, visible)
+	return m
+}
+
+// indexes returns the indexes of the items matching the filter, best first.
+func indexes(m Model) (visible []int) {
+	if m.filter == "" {
+		visible = make([]int, len(m.Items))
+		for i := range m.Items {
+			visible[i] = i
+		}
+		return
+	}
+
+	values := make([]string, len(m.Items))
+	for i, item := range m.Items {
+		values[i] = item.FilterValue()
+	}
+
+	for _, match := range fuzzy.Find(m.filter, values) {
+		visible = append(visible, match.Index)
+	}
+	return
+}
+
+// step moves the active item by n items, wrapping around the ends when enabled.
+func step(m Model, n int) (Model, tea.Cmd) {
+	visible := indexes(m)
+	i, ok := menu.Next(visible, m.Selected, n, m.Wrap)
+	if !ok {
+		return m, tea.Bell
+	}
+
+	m.Selected = i
+	m.offset, _ = window(m, visible)
+	return m, nil
+}
+
+// jump moves the active item to position p, stopping at the ends.
+func jump(m Model, p int) (Model, tea.Cmd) {
+	visible := indexes(m)
+	i, ok := menu.Seek(visible, m.Selected, p)
+	if !ok {
+		return m, tea.Bell
+	}
+
+	m.Selected = i
+	m.offset, _ = window(m, visible)
+	return m, nil
+}
+
+// page returns the number of items moved by a page.
+func page(m Model) int {
+	if m.Height <= 0 {
+		return len(m.Items)
+	}
+
+	d := delegate(m)
+	h := 1
+	if item := m.SelectedItem(); item != nil {
+		h = menu.Max(1, d.Height(item))
+	}
+
+	return menu.Max(1, (available(m, indexes(m))-2)/h)
+}
+
+// available returns the number of lines of the height
+// remaining for the visible items beneath the filter.
+func available(m Model, visible []int) int {
+	_, status := menu.Status(m.filter, len(visible))
+	return m.Height - status
+}
+
+// window returns the positions of the visible items displayed, from the
+// offset and keeping the active item in view, within the height beneath
+// the filter. When scrolled a line is reserved for each indicator.
+func window(m Model, visible []int) (from, to int) {
+	d := delegate(m)
+
+	lines := 0
+	for _, i := range visible {
+		lines += d.Height(m.Items[i])
+	}
+
+	if m.Height <= 0 || lines <= available(m, visible) {
+		return 0, len(visible)
+	}
+
+	avail := menu.Max(1, available(m, visible)-2)
+	from = menu.Clamp(m.offset, 0, len(visible)-1)
+	p := menu.Position(visible, m.Selected)
+
+	// scroll up to the active item
+	if p >= 0 && p < from {
+		from = p
+	}
+
+	// scroll down until the active item fits
+	for p >= 0 && from < p && height(m, visible[from:p+1]) > avail {
+		from++
+	}
+
+	// fill the available lines
+	to = from
+	for to < len(visible) && height(m, visible[from:to+1]) <= avail {
+		to++
+	}
+
+	// always display at least one item
+	if to == from {
+		to++
+	}
+
+	return from, to
+}
+
+// height returns the number of lines of the items at the given indexes.
+func height(m Model, indexes []int) (n int) {
+	d := delegate(m)
+	for _, i := range indexes {
+		n += d.Height(m.Items[i])
+	}
+	return
+}
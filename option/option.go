@@ -2,65 +2,265 @@
 package option
 
 import (
-	"bytes"
-	"fmt"
+	"unicode"
 
 	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/internal/menu"
+	"github.com/tj/go-tea/style"
+	"github.com/tj/go-tea/theme"
 	"github.com/tj/go-terminput"
 )
 
+// Hotkeys is the kind of hotkeys used to select options.
+type Hotkeys int
+
+// Hotkeys available.
+const (
+	// NoHotkeys disables hotkeys.
+	NoHotkeys Hotkeys = iota
+
+	// IndexHotkeys selects the first nine enabled options with "1" to "9".
+	IndexHotkeys
+
+	// MnemonicHotkeys selects options with a letter or digit of their label,
+	// assigned from the start of words first so that no two options collide.
+	MnemonicHotkeys
+)
+
+// Item is an option, disabled option or group header.
+type Item = menu.Item
+
 // Model is the option input model.
 type Model struct {
 	// Options is the set of options the user can select.
 	Options []string
 
+	// Items is the set of options, disabled options and headers,
+	// used instead of Options when present.
+	Items []Item
+
 	// Selected is the index of the selected value.
 	Selected int
+
+	// Height is the maximum number of lines used to display the options,
+	// including the indicators of options scrolled out of view. The options
+	// are scrolled to keep the selection in view. Defaults to displaying all options.
+	Height int
+
+	// Wrap moves from the last option to the first, and the first option to the
+	// last, instead of ringing the bell.
+	Wrap bool
+
+	// Filterable enables narrowing the options by typing, using fuzzy
+	// matching. Backspace removes a character and Escape clears the filter.
+	// Hotkeys are ignored when enabled.
+	Filterable bool
+
+	// Hotkeys enables selecting an option with a single key,
+	// displayed before each option. Defaults to NoHotkeys.
+	Hotkeys Hotkeys
+
+	// SubmitOnHotkey submits the option selected by a hotkey.
+	SubmitOnHotkey bool
+
+	// Submitted is set when Enter is pressed, or an option
+	// is selected by a hotkey when SubmitOnHotkey is enabled.
+	Submitted bool
+
+	// filter is the text typed to filter the options.
+	filter string
+
+	// offset is the position of the first option displayed.
+	offset int
 }
 
-// Value returns the selected option.
+// Value returns the selected option, or an empty string when there
+// is no selection, such as when no options match the filter.
 func (m *Model) Value() string {
-	return m.Options[m.Selected]
+	if !m.HasValue() {
+		return ""
+	}
+	n := menu.Normalize(m.menu())
+	return n.Items[n.Active].Label
+}
+
+// HasValue returns true if an option is selected, or false
+// when there is none, such as when no options match the filter.
+func (m *Model) HasValue() bool {
+	n := menu.Normalize(m.menu())
+	return menu.Position(menu.Choices(n), n.Active) >= 0
+}
+
+// Filter returns the text typed to filter the options.
+func (m *Model) Filter() string {
+	return m.filter
+}
+
+// Filtering returns true if the options are filtered.
+func (m *Model) Filtering() bool {
+	return m.filter != ""
 }
 
 // Update function.
-func Update(msg tea.Msg, m Model) Model {
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	n := menu.Normalize(m.menu())
+	m.set(n)
+
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
+	case tea.KeyMsg:
 		switch msg.Key() {
-		case terminput.KeyUp:
-			if m.Selected > 0 {
-				m.Selected--
-			} else {
-				bell()
+		case terminput.KeyEnter:
+			if !m.HasValue() {
+				return m, tea.Bell
 			}
-		case terminput.KeyDown:
-			if m.Selected < len(m.Options)-1 {
-				m.Selected++
-			} else {
-				bell()
+			m.Submitted = true
+			return m, nil
+		case terminput.KeyRune:
+			if i, ok := hotkeys(m)[unicode.ToLower(msg.Rune())]; ok && !msg.Alt() {
+				return hotkey(m, i)
 			}
 		}
+
+		n, cmd := menu.Update(msg, n)
+		m.set(n)
+		return m, cmd
 	}
-	return m
+	return m, nil
 }
 
 // View function.
 func View(m Model) string {
-	w := new(bytes.Buffer)
+	n := menu.Normalize(m.menu())
 
-	for i, option := range m.Options {
-		if i == m.Selected {
-			fmt.Fprintf(w, "  \033[1m%s\033[m\n", option)
-		} else {
-			fmt.Fprintf(w, "  %s\n", option)
+	keys := make(map[int]rune)
+	for r, i := range hotkeys(m) {
+		keys[i] = r
+	}
+
+	return menu.View(n, func(i int, s style.Style) string {
+		return hotkeyHint(keys, i)
+	})
+}
+
+// menu returns the menu model of the options.
+func (m *Model) menu() menu.Model {
+	items := m.Items
+	if items == nil {
+		items = menu.Items(m.Options)
+	}
+
+	return menu.Model{
+		Items:      items,
+		Active:     m.Selected,
+		Height:     m.Height,
+		Wrap:       m.Wrap,
+		Filterable: m.Filterable,
+		Filter:     m.filter,
+		Offset:     m.offset,
+	}
+}
+
+// set the selection, filter and scroll position from the menu model.
+func (m *Model) set(n menu.Model) {
+	m.Selected = n.Active
+	m.filter = n.Filter
+	m.offset = n.Offset
+}
+
+// hotkey selects index i, submitting it when enabled.
+func hotkey(m Model, i int) (Model, tea.Cmd) {
+	m.set(menu.MoveTo(m.menu(), i))
+	if m.SubmitOnHotkey {
+		m.Submitted = true
+	}
+	return m, nil
+}
+
+// hotkeys returns the index of the item selected by each hotkey.
+func hotkeys(m Model) map[rune]int {
+	keys := make(map[rune]int)
+	if m.Filterable {
+		return keys
+	}
+
+	items := m.menu().Items
+	switch m.Hotkeys {
+	case IndexHotkeys:
+		n := 0
+		for i, item := range items {
+			if menu.Selectable(item) && n < 9 {
+				keys['1'+rune(n)] = i
+				n++
+			}
+		}
+	case MnemonicHotkeys:
+		// explicit hotkeys take precedence
+		for i, item := range items {
+			if menu.Selectable(item) && item.Hotkey != 0 {
+				keys[unicode.ToLower(item.Hotkey)] = i
+			}
+		}
+
+		// then the start of words, then any letter or digit
+		for _, words := range []bool{true, false} {
+			for i, item := range items {
+				if !menu.Selectable(item) || assigned(keys, i) {
+					continue
+				}
+				if r, ok := mnemonic(keys, item.Label, words); ok {
+					keys[r] = i
+				}
+			}
 		}
 	}
 
-	return w.String()
+	return keys
 }
 
-// bell sound.
-func bell() {
-	fmt.Printf("\a")
+// mnemonic returns the first letter or digit of label which is not yet
+// assigned, optionally only considering the start of words.
+func mnemonic(keys map[rune]int, label string, words bool) (rune, bool) {
+	prev := ' '
+	for _, r := range label {
+		start := !unicode.IsLetter(prev) && !unicode.IsDigit(prev)
+		prev = r
+		r = unicode.ToLower(r)
+
+		if words && !start {
+			continue
+		}
+
+		if _, ok := keys[r]; ok {
+			continue
+		}
+
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r, true
+		}
+	}
+	return 0, false
+}
+
+// assigned returns true if index i has a hotkey.
+func assigned(keys map[rune]int, i int) bool {
+	for _, v := range keys {
+		if v == i {
+			return true
+		}
+	}
+	return false
+}
+
+// hotkeyHint renders the hotkey of index i, padded to align options without one.
+func hotkeyHint(keys map[int]rune, i int) string {
+	if len(keys) == 0 {
+		return ""
+	}
+
+	r, ok := keys[i]
+	if !ok {
+		return "    "
+	}
+
+	return theme.Current().Muted.Render("["+string(r)+"]") + " "
 }
@@ -2,109 +2,325 @@
 package options
 
 import (
-	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/internal/menu"
+	"github.com/tj/go-tea/key"
+	"github.com/tj/go-tea/style"
+	"github.com/tj/go-tea/theme"
 	"github.com/tj/go-terminput"
 )
 
+// Item is an option, disabled option or group header.
+type Item = menu.Item
+
 // Model is the options input model.
 type Model struct {
 	// Options is the set of options the user can select.
 	Options []string
 
-	// Selected is the indexes of the selected values.
+	// Items is the set of options, disabled options and headers,
+	// used instead of Options when present.
+	Items []Item
+
+	// Selected is the indexes of the selected values, in ascending order.
 	Selected []int
 
+	// Min is the minimum number of selected options required to submit.
+	Min int
+
+	// Max is the maximum number of selected options, ignored when zero.
+	Max int
+
+	// Submitted is set when Enter is pressed with a valid selection.
+	Submitted bool
+
+	// Err is the current selection error, which is rendered beneath the options.
+	Err error
+
+	// Height is the maximum number of lines of options displayed, scrolled
+	// to keep the active option in view. Defaults to displaying all options.
+	Height int
+
+	// Wrap moves the active option past either end to the other.
+	Wrap bool
+
+	// Filterable enables fuzzy filtering of the options by typing,
+	// which replaces the selection shortcuts.
+	Filterable bool
+
 	// active index.
 	index int
+
+	// ranging is true while a range is being selected.
+	ranging bool
+
+	// anchor is the index where the range selection started.
+	anchor int
+
+	// base is the selection before the range selection started.
+	base []int
+
+	// filter is the text typed to filter the options.
+	filter string
+
+	// offset is the position of the first option displayed.
+	offset int
 }
 
 // Value returns the selected option.
 func (m *Model) Value() (values []string) {
+	items := m.menu().Items
 	for _, i := range m.Selected {
-		if i < len(m.Options) {
-			values = append(values, m.Options[i])
+		if i < len(items) {
+			values = append(values, items[i].Label)
 		}
 	}
 	return
 }
 
-// Update function.
-func Update(msg tea.Msg, m Model) Model {
+// Valid validates the number of selected options, returning true if it is valid.
+func (m *Model) Valid() bool {
+	m.Err = validate(*m, len(m.Selected))
+	if m.Err == nil && len(m.Selected) < m.Min {
+		m.Err = fmt.Errorf("select at least %s", plural(m.Min))
+	}
+	return m.Err == nil
+}
+
+// Filter returns the text typed to filter the options.
+func (m *Model) Filter() string {
+	return m.filter
+}
+
+// Filtering returns true if the options are filtered.
+func (m *Model) Filtering() bool {
+	return m.filter != ""
+}
+
+// Update function. Space toggles the active option, Shift+Up and Shift+Down
+// (or Alt+Up and Alt+Down) select a range, and Enter submits the selection.
+// Unless the options are filterable, where typing filters them, "a" selects
+// all options, "n" selects none, "i" inverts the selection, and "K" and "J"
+// also select a range.
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	n := menu.Normalize(m.menu())
+	m.set(n)
+
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
-		switch msg.Key() {
-		case terminput.KeyUp:
-			if m.index > 0 {
-				m.index--
-			} else {
-				bell()
-			}
-		case terminput.KeyDown:
-			if m.index < len(m.Options)-1 {
-				m.index++
-			} else {
-				bell()
+	case tea.KeyMsg:
+		shortcuts := !m.Filterable
+
+		switch {
+		case key.Matches(msg, "shift+up", "alt+up") || shortcuts && key.Matches(msg, "K"):
+			return extend(m, -1)
+		case key.Matches(msg, "shift+down", "alt+down") || shortcuts && key.Matches(msg, "J"):
+			return extend(m, 1)
+		}
+
+		m.ranging = false
+
+		switch {
+		case msg.Key() == terminput.KeyEnter:
+			if !m.Valid() {
+				return m, tea.Bell
 			}
-		case terminput.KeyRune:
-			if msg.Rune() == ' ' {
-				return toggle(m)
+			m.Submitted = true
+			return m, nil
+		case key.Matches(msg, "space"):
+			if menu.Position(menu.Choices(n), m.index) < 0 {
+				return m, tea.Bell
 			}
+			return toggle(m)
+		case shortcuts && key.Matches(msg, "a"):
+			return selectAll(m)
+		case shortcuts && key.Matches(msg, "n"):
+			return selectNone(m)
+		case shortcuts && key.Matches(msg, "i"):
+			return invert(m)
 		}
+
+		n, cmd := menu.Update(msg, n)
+		m.set(n)
+		return m, cmd
 	}
-	return m
+	return m, nil
 }
 
 // View function.
 func View(m Model) string {
-	w := new(bytes.Buffer)
-
-	for i, option := range m.Options {
-		if i == m.index {
-			fmt.Fprintf(w, "\033[1m")
-		}
+	t := theme.Current()
 
+	s := menu.View(menu.Normalize(m.menu()), func(i int, s style.Style) string {
+		glyph := t.Glyphs.Unchecked
 		if isSelected(m, i) {
-			fmt.Fprintf(w, "  ■ %s\n", option)
-		} else {
-			fmt.Fprintf(w, "  □ %s\n", option)
+			glyph = t.Glyphs.Checked
 		}
+		return s.Render(glyph + " ")
+	})
 
-		if i == m.index {
-			fmt.Fprintf(w, "\033[0m")
-		}
+	if m.Err != nil {
+		s += fmt.Sprintf("  %s\n", t.Error.Render(m.Err.Error()))
 	}
 
-	return w.String()
+	return s
+}
+
+// menu returns the menu model of the options.
+func (m *Model) menu() menu.Model {
+	items := m.Items
+	if items == nil {
+		items = menu.Items(m.Options)
+	}
+
+	return menu.Model{
+		Items:      items,
+		Active:     m.index,
+		Height:     m.Height,
+		Wrap:       m.Wrap,
+		Filterable: m.Filterable,
+		Filter:     m.filter,
+		Offset:     m.offset,
+	}
+}
+
+// set the active option, filter and scroll position from the menu model.
+func (m *Model) set(n menu.Model) {
+	m.index = n.Active
+	m.filter = n.Filter
+	m.offset = n.Offset
 }
 
 // toggle selection at the current index.
-func toggle(m Model) Model {
+func toggle(m Model) (Model, tea.Cmd) {
 	if isSelected(m, m.index) {
-		for i, v := range m.Selected {
-			if v == m.index {
-				m.Selected = append(m.Selected[:i], m.Selected[i+1:]...)
+		var selected []int
+		for _, i := range m.Selected {
+			if i != m.index {
+				selected = append(selected, i)
 			}
 		}
-	} else {
-		m.Selected = append(m.Selected, m.index)
+		return selectIndexes(m, selected)
+	}
+
+	selected := append([]int{m.index}, m.Selected...)
+	return selectIndexes(m, selected)
+}
+
+// selectAll selects every option, except disabled options.
+func selectAll(m Model) (Model, tea.Cmd) {
+	var selected []int
+	for i, item := range m.menu().Items {
+		if menu.Selectable(item) || isSelected(m, i) {
+			selected = append(selected, i)
+		}
+	}
+	return selectIndexes(m, selected)
+}
+
+// selectNone clears the selection.
+func selectNone(m Model) (Model, tea.Cmd) {
+	return selectIndexes(m, nil)
+}
+
+// invert selects the options which are not selected, and deselects those
+// which are. Disabled options are left unchanged.
+func invert(m Model) (Model, tea.Cmd) {
+	var selected []int
+	for i, item := range m.menu().Items {
+		if menu.Selectable(item) != isSelected(m, i) {
+			selected = append(selected, i)
+		}
 	}
-	return m
+	return selectIndexes(m, selected)
+}
+
+// extend moves the active option by n options, selecting the options
+// between it and the option active when the range selection started.
+func extend(m Model, n int) (Model, tea.Cmd) {
+	prev := m
+	if !m.ranging {
+		m.ranging = true
+		m.anchor = m.index
+		m.base = m.Selected
+	}
+
+	// ranges stop at the ends rather than wrapping
+	visible := menu.Choices(m.menu())
+	i, ok := menu.Next(visible, m.index, n, false)
+	if !ok {
+		return m, tea.Bell
+	}
+	m.set(menu.MoveTo(m.menu(), i))
+
+	// start from the first option reached when the range began on a header
+	if menu.Position(visible, m.anchor) < 0 {
+		m.anchor = m.index
+	}
+
+	from, to := menu.Position(visible, m.anchor), menu.Position(visible, m.index)
+	if from > to {
+		from, to = to, from
+	}
+
+	selected := append([]int(nil), m.base...)
+	for _, i := range visible[from : to+1] {
+		if !contains(m.base, i) {
+			selected = append(selected, i)
+		}
+	}
+
+	m, cmd := selectIndexes(m, selected)
+	if cmd != nil {
+		prev.Err = m.Err
+		return prev, cmd
+	}
+	return m, nil
+}
+
+// selectIndexes sets the selection to the given indexes in ascending
+// order, ringing the bell when it exceeds the maximum.
+func selectIndexes(m Model, selected []int) (Model, tea.Cmd) {
+	if err := validate(m, len(selected)); err != nil {
+		m.Err = err
+		return m, tea.Bell
+	}
+
+	sort.Ints(selected)
+	m.Selected = selected
+	m.Err = nil
+	return m, nil
+}
+
+// validate returns an error if n selected options exceeds the maximum.
+func validate(m Model, n int) error {
+	if m.Max > 0 && n > m.Max {
+		return fmt.Errorf("select at most %s", plural(m.Max))
+	}
+	return nil
+}
+
+// plural returns n options, in singular form when n is one.
+func plural(n int) string {
+	if n == 1 {
+		return "1 option"
+	}
+	return fmt.Sprintf("%d options", n)
 }
 
 // isSelected returns true if the index is selected.
 func isSelected(m Model, index int) bool {
-	for _, i := range m.Selected {
+	return contains(m.Selected, index)
+}
+
+// contains returns true if the indexes contain index.
+func contains(indexes []int, index int) bool {
+	for _, i := range indexes {
 		if i == index {
 			return true
 		}
 	}
 	return false
 }
-
-// bell sound.
-func bell() {
-	fmt.Printf("\a")
-}
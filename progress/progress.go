@@ -5,14 +5,16 @@ import (
 	"fmt"
 	"math"
 	"strings"
+
+	"github.com/tj/go-tea/theme"
 )
 
 // Model is the progress bar model.
 type Model struct {
-	// Filled bar character, defaulting to '█'.
+	// Filled bar character, defaulting to the theme's BarFilled glyph.
 	Filled string
 
-	// Empty bar character, defaulting to '░'.
+	// Empty bar character, defaulting to the theme's BarEmpty glyph.
 	Empty string
 
 	// Width of the progress bar, defaulting to 24.
@@ -28,8 +30,9 @@ func View(m Model) string {
 	if w == 0 {
 		w = 24
 	}
-	f := defaultString(m.Filled, "█")
-	e := defaultString(m.Empty, "░")
+	g := theme.Current().Glyphs
+	f := defaultString(m.Filled, g.BarFilled)
+	e := defaultString(m.Empty, g.BarEmpty)
 	nf := int(math.Ceil(float64(w) * m.Percent))
 	ne := w - nf
 	bar := strings.Repeat(f, nf) + strings.Repeat(e, ne)
@@ -0,0 +1,172 @@
+// Package sequence provides multi-key sequence bindings such as "g g" or "ctrl+x ctrl+s".
+package sequence
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/key"
+)
+
+// DefaultTimeout is the default time allowed between the keys of a sequence.
+var DefaultTimeout = time.Second
+
+// ids is used to generate unique timeout ids.
+var ids int64
+
+// timeoutMsg is the internal message for expiring a pending sequence.
+type timeoutMsg struct {
+	id int64
+}
+
+// Binding is a key sequence bound to an action.
+type Binding struct {
+	// Keys is the space-delimited sequence of key names, such as "g g" or "ctrl+x ctrl+s".
+	Keys string
+
+	// Help is an optional description such as "Save".
+	Help string
+
+	// Action is the message emitted when the sequence is completed.
+	Action tea.Msg
+}
+
+// Model is the sequence model.
+type Model struct {
+	// Bindings is the set of key sequences available.
+	Bindings []Binding
+
+	// Timeout is the time allowed between keys. Defaults to DefaultTimeout.
+	Timeout time.Duration
+
+	// pending keys of a partial sequence.
+	pending []string
+
+	// id of the pending timeout.
+	id int64
+}
+
+// Pending returns the pending keys of a partial sequence, or an empty string.
+func (m *Model) Pending() string {
+	return strings.Join(m.pending, " ")
+}
+
+// Candidates returns the bindings which may complete the pending sequence.
+func (m *Model) Candidates() (bindings []Binding) {
+	if len(m.pending) == 0 {
+		return
+	}
+	for _, b := range m.Bindings {
+		keys := strings.Fields(b.Keys)
+		if len(keys) > len(m.pending) && hasPrefix(keys, m.pending) {
+			bindings = append(bindings, b)
+		}
+	}
+	return
+}
+
+// Update function. A non-nil command is returned when the key was consumed,
+// either as part of a pending sequence, or by completing one, in which case
+// the command emits the binding's action.
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case timeoutMsg:
+		if msg.id != m.id || len(m.pending) == 0 {
+			return m, nil
+		}
+		b, _ := lookup(m, m.pending)
+		m.pending = nil
+		if b != nil {
+			return m, emit(b.Action)
+		}
+		return m, nil
+	case tea.KeyMsg:
+		name := key.Name(msg)
+		keys := append(append([]string{}, m.pending...), name)
+		b, prefix := lookup(m, keys)
+
+		// partial sequence, wait for more keys
+		if prefix {
+			m.pending = keys
+			m.id = atomic.AddInt64(&ids, 1)
+			return m, wait(m.id, timeout(m))
+		}
+
+		// completed sequence
+		if b != nil {
+			m.pending = nil
+			return m, emit(b.Action)
+		}
+
+		// broken sequence, try the key on its own
+		if len(m.pending) > 0 {
+			m.pending = nil
+			return Update(msg, m)
+		}
+	}
+	return m, nil
+}
+
+// View function.
+func View(m Model) string {
+	if len(m.pending) == 0 {
+		return ""
+	}
+	return m.Pending() + "-"
+}
+
+// lookup returns the binding matching keys exactly, if any, and
+// true when keys is a prefix of one or more longer bindings.
+func lookup(m Model, keys []string) (match *Binding, prefix bool) {
+	for i, b := range m.Bindings {
+		bkeys := strings.Fields(b.Keys)
+		if !hasPrefix(bkeys, keys) {
+			continue
+		}
+		if len(bkeys) == len(keys) {
+			match = &m.Bindings[i]
+		} else {
+			prefix = true
+		}
+	}
+	return
+}
+
+// hasPrefix returns true if s begins with prefix.
+func hasPrefix(s, prefix []string) bool {
+	if len(prefix) > len(s) {
+		return false
+	}
+	for i := range prefix {
+		if s[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// timeout returns the timeout or default.
+func timeout(m Model) time.Duration {
+	if m.Timeout == 0 {
+		return DefaultTimeout
+	}
+	return m.Timeout
+}
+
+// wait is a command which expires the pending sequence after d.
+func wait(id int64, d time.Duration) tea.Cmd {
+	return func(ctx context.Context) tea.Msg {
+		time.Sleep(d)
+		return timeoutMsg{id}
+	}
+}
+
+// emit is a command which emits the given action.
+func emit(msg tea.Msg) tea.Cmd {
+	return func(ctx context.Context) tea.Msg {
+		return msg
+	}
+}
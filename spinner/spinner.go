@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/theme"
 )
 
 // Msg is a spinner message.
@@ -19,14 +20,15 @@ const (
 // DefaultInterval is the default animation interval used.
 var DefaultInterval = time.Millisecond * 75
 
-// DefaultFrames is the default set of frames used.
-var DefaultFrames = []string{
-	"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏",
-}
+// DefaultFrames is the default set of frames used by the Dark theme.
+//
+// Deprecated: Frames defaults to the current theme's spinner glyphs,
+// use theme.Current().Glyphs.Spinner instead.
+var DefaultFrames = theme.Dark.Glyphs.Spinner
 
 // Model is the input model.
 type Model struct {
-	// Frames is a set of frames to animation. Defaults to DefaultFrames.
+	// Frames is a set of frames to animation. Defaults to the theme's spinner glyphs.
 	Frames []string
 
 	// Interval is the animation update interval. Defaults to DefaultInterval.
@@ -56,7 +58,7 @@ func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
 func View(m Model) string {
 	frames := m.Frames
 	if frames == nil {
-		frames = DefaultFrames
+		frames = theme.Current().Glyphs.Spinner
 	}
 	frame := (m.tick + 1) % len(frames)
 	return frames[frame]
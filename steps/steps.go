@@ -1,19 +1,12 @@
 // Package steps provides a wizard style step progress bar.
 package steps
 
-import "strings"
+import (
+	"strings"
 
-// stepCompletedChar is the character used for a completed step.
-var stepCompletedChar = "◉"
-
-// stepChar is the character used for a step.
-var stepChar = "◯"
-
-// barCompletedChar is the character used for the completed portion of the bar.
-var barCompletedChar = "━"
-
-// barChar is the character used for the uncompleted portion of the bar.
-var barChar = "━"
+	"github.com/tj/go-tea/text"
+	"github.com/tj/go-tea/theme"
+)
 
 // Model is the step model.
 type Model struct {
@@ -32,6 +25,8 @@ func View(m Model) (s string) {
 	pad := 8
 	max := maxLength(m.Steps) + pad
 
+	g := theme.Current().Glyphs
+
 	s += m.Prefix
 
 	// progress bar
@@ -39,16 +34,16 @@ func View(m Model) (s string) {
 		complete := i <= m.Step
 
 		if complete {
-			s += stepCompletedChar
+			s += g.StepCompleted
 		} else {
-			s += stepChar
+			s += g.Step
 		}
 
 		if i < len(m.Steps)-1 {
 			if complete {
-				s += strings.Repeat(barCompletedChar, max)
+				s += strings.Repeat(g.StepBarCompleted, max)
 			} else {
-				s += strings.Repeat(barChar, max)
+				s += strings.Repeat(g.StepBar, max)
 			}
 		}
 	}
@@ -62,16 +57,16 @@ func View(m Model) (s string) {
 		// first step, left align
 		case i == 0:
 			s += step
-			lpad = max + 1 - len(step)
+			lpad = max + 1 - text.Width(step)
 		// last step, right align
 		case i == len(m.Steps)-1:
-			lpad -= len(step)
+			lpad -= text.Width(step)
 			s += strings.Repeat(" ", lpad) + step
 		// others, center
 		default:
-			lpad -= len(step) / 2
+			lpad -= text.Width(step) / 2
 			s += strings.Repeat(" ", lpad) + step
-			lpad = max + 1 - len(step)/2
+			lpad = max + 1 - text.Width(step)/2
 		}
 	}
 
@@ -80,11 +75,11 @@ func View(m Model) (s string) {
 	return
 }
 
-// maxLength returns the max length of the given strings.
+// maxLength returns the max display width of the given strings.
 func maxLength(values []string) (max int) {
 	for _, s := range values {
-		if len(s) > max {
-			max = len(s)
+		if w := text.Width(s); w > max {
+			max = w
 		}
 	}
 	return
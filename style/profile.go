@@ -0,0 +1,77 @@
+package style
+
+import (
+	"os"
+	"strings"
+)
+
+// Profile is a terminal color profile.
+type Profile int
+
+// Profiles available.
+const (
+	// NoColor disables colors, text attributes such as bold are still applied.
+	NoColor Profile = iota
+
+	// ANSI supports the 16 basic colors.
+	ANSI
+
+	// ANSI256 supports the 256 color palette.
+	ANSI256
+
+	// TrueColor supports 24-bit colors.
+	TrueColor
+)
+
+// String implementation.
+func (p Profile) String() string {
+	switch p {
+	case NoColor:
+		return "none"
+	case ANSI:
+		return "16"
+	case ANSI256:
+		return "256"
+	case TrueColor:
+		return "truecolor"
+	default:
+		return "unknown"
+	}
+}
+
+// profile is the active color profile.
+var profile = DetectProfile()
+
+// CurrentProfile returns the active color profile.
+func CurrentProfile() Profile {
+	return profile
+}
+
+// SetProfile sets the active color profile, overriding detection.
+func SetProfile(p Profile) {
+	profile = p
+}
+
+// DetectProfile returns the color profile of the terminal based on
+// the NO_COLOR, COLORTERM and TERM environment variables.
+func DetectProfile() Profile {
+	if os.Getenv("NO_COLOR") != "" {
+		return NoColor
+	}
+
+	term := os.Getenv("TERM")
+	colorterm := strings.ToLower(os.Getenv("COLORTERM"))
+
+	switch {
+	case term == "dumb":
+		return NoColor
+	case colorterm == "truecolor" || colorterm == "24bit":
+		return TrueColor
+	case strings.Contains(term, "truecolor") || strings.Contains(term, "direct"):
+		return TrueColor
+	case strings.Contains(term, "256color"):
+		return ANSI256
+	default:
+		return ANSI
+	}
+}
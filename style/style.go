@@ -0,0 +1,302 @@
+// Package style provides terminal text styling with color profile detection.
+package style
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Style is a set of text attributes and colors. The zero value renders text as-is.
+type Style struct {
+	fg        Color
+	bg        Color
+	bold      bool
+	faint     bool
+	italic    bool
+	underline bool
+	reverse   bool
+}
+
+// New returns a new style.
+func New() Style {
+	return Style{}
+}
+
+// Foreground returns a copy of the style with the given foreground color.
+func (s Style) Foreground(c Color) Style {
+	s.fg = c
+	return s
+}
+
+// Background returns a copy of the style with the given background color.
+func (s Style) Background(c Color) Style {
+	s.bg = c
+	return s
+}
+
+// Bold returns a copy of the style with bold text.
+func (s Style) Bold() Style {
+	s.bold = true
+	return s
+}
+
+// Faint returns a copy of the style with faint text.
+func (s Style) Faint() Style {
+	s.faint = true
+	return s
+}
+
+// Italic returns a copy of the style with italic text.
+func (s Style) Italic() Style {
+	s.italic = true
+	return s
+}
+
+// Underline returns a copy of the style with underlined text.
+func (s Style) Underline() Style {
+	s.underline = true
+	return s
+}
+
+// Reverse returns a copy of the style with the foreground and background swapped.
+func (s Style) Reverse() Style {
+	s.reverse = true
+	return s
+}
+
+// Render returns the string with the style applied for the current color profile.
+// Each line is styled separately so that multi-line strings may be rendered
+// line by line.
+func (s Style) Render(str string) string {
+	seq := s.sequence(profile)
+	if seq == "" {
+		return str
+	}
+	lines := strings.Split(str, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = seq + line + "\033[0m"
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Sprintf formats according to the format specifier and renders the result.
+func (s Style) Sprintf(format string, args ...interface{}) string {
+	return s.Render(fmt.Sprintf(format, args...))
+}
+
+// sequence returns the escape sequence for the style in the given profile.
+func (s Style) sequence(p Profile) string {
+	var params []string
+
+	if s.bold {
+		params = append(params, "1")
+	}
+
+	if s.faint {
+		params = append(params, "2")
+	}
+
+	if s.italic {
+		params = append(params, "3")
+	}
+
+	if s.underline {
+		params = append(params, "4")
+	}
+
+	if s.reverse {
+		params = append(params, "7")
+	}
+
+	if s.fg != "" {
+		if v := s.fg.sequence(p, false); v != "" {
+			params = append(params, v)
+		}
+	}
+
+	if s.bg != "" {
+		if v := s.bg.sequence(p, true); v != "" {
+			params = append(params, v)
+		}
+	}
+
+	if len(params) == 0 {
+		return ""
+	}
+
+	return "\033[" + strings.Join(params, ";") + "m"
+}
+
+// Color is an ANSI color index from "0" to "255", or a hex color such as "#5f5fd7".
+type Color string
+
+// Basic colors.
+const (
+	Black         Color = "0"
+	Red           Color = "1"
+	Green         Color = "2"
+	Yellow        Color = "3"
+	Blue          Color = "4"
+	Magenta       Color = "5"
+	Cyan          Color = "6"
+	White         Color = "7"
+	BrightBlack   Color = "8"
+	BrightRed     Color = "9"
+	BrightGreen   Color = "10"
+	BrightYellow  Color = "11"
+	BrightBlue    Color = "12"
+	BrightMagenta Color = "13"
+	BrightCyan    Color = "14"
+	BrightWhite   Color = "15"
+)
+
+// sequence returns the SGR parameters for the color, downsampled to the profile.
+func (c Color) sequence(p Profile, bg bool) string {
+	if p == NoColor {
+		return ""
+	}
+
+	rgb, index, ok := c.parse()
+	if !ok {
+		return ""
+	}
+
+	// truecolor
+	if index < 0 && p == TrueColor {
+		if bg {
+			return fmt.Sprintf("48;2;%d;%d;%d", rgb[0], rgb[1], rgb[2])
+		}
+		return fmt.Sprintf("38;2;%d;%d;%d", rgb[0], rgb[1], rgb[2])
+	}
+
+	// downsample to 256
+	if index < 0 {
+		index = nearest256(rgb)
+	}
+
+	// 256 colors
+	if index > 15 && p >= ANSI256 {
+		if bg {
+			return fmt.Sprintf("48;5;%d", index)
+		}
+		return fmt.Sprintf("38;5;%d", index)
+	}
+
+	// downsample to 16
+	if index > 15 {
+		index = nearest16(palette(index))
+	}
+
+	// 16 colors
+	base := 30
+	if index > 7 {
+		base = 90
+		index -= 8
+	}
+
+	if bg {
+		base += 10
+	}
+
+	return strconv.Itoa(base + index)
+}
+
+// parse returns the rgb value of the color, and its palette index or -1 for hex colors.
+func (c Color) parse() (rgb [3]int, index int, ok bool) {
+	s := string(c)
+
+	// hex
+	if strings.HasPrefix(s, "#") {
+		if len(s) != 7 {
+			return rgb, 0, false
+		}
+		for i := range rgb {
+			v, err := strconv.ParseUint(s[1+i*2:3+i*2], 16, 8)
+			if err != nil {
+				return rgb, 0, false
+			}
+			rgb[i] = int(v)
+		}
+		return rgb, -1, true
+	}
+
+	// index
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 || n > 255 {
+		return rgb, 0, false
+	}
+
+	return palette(n), n, true
+}
+
+// ansi is the rgb value of the 16 basic colors, using the xterm defaults.
+var ansi = [16][3]int{
+	{0, 0, 0},
+	{205, 0, 0},
+	{0, 205, 0},
+	{205, 205, 0},
+	{0, 0, 238},
+	{205, 0, 205},
+	{0, 205, 205},
+	{229, 229, 229},
+	{127, 127, 127},
+	{255, 0, 0},
+	{0, 255, 0},
+	{255, 255, 0},
+	{92, 92, 255},
+	{255, 0, 255},
+	{0, 255, 255},
+	{255, 255, 255},
+}
+
+// cube is the channel values of the 6x6x6 color cube.
+var cube = [6]int{0, 95, 135, 175, 215, 255}
+
+// palette returns the rgb value of a 256 color palette index.
+func palette(n int) [3]int {
+	switch {
+	case n < 16:
+		return ansi[n]
+	case n < 232:
+		n -= 16
+		return [3]int{cube[n/36], cube[n/6%6], cube[n%6]}
+	default:
+		v := 8 + (n-232)*10
+		return [3]int{v, v, v}
+	}
+}
+
+// nearest256 returns the nearest 256 color palette index, excluding the basic colors.
+func nearest256(rgb [3]int) int {
+	best, dist := 16, -1
+	for n := 16; n < 256; n++ {
+		if d := distance(rgb, palette(n)); dist < 0 || d < dist {
+			best, dist = n, d
+		}
+	}
+	return best
+}
+
+// nearest16 returns the nearest basic color index.
+func nearest16(rgb [3]int) int {
+	best, dist := 0, -1
+	for n, c := range ansi {
+		if d := distance(rgb, c); dist < 0 || d < dist {
+			best, dist = n, d
+		}
+	}
+	return best
+}
+
+// distance returns the squared distance between two colors.
+func distance(a, b [3]int) int {
+	var d int
+	for i := range a {
+		v := a[i] - b[i]
+		d += v * v
+	}
+	return d
+}
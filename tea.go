@@ -5,10 +5,14 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/pkg/term"
-	"github.com/tj/go-terminput"
+	"github.com/tj/go-tea/text"
 )
 
 // quitMsg is the internal message for exiting the program.
@@ -17,6 +21,37 @@ type quitMsg struct{}
 // batchMsg is the internal message for performing a batch of commands.
 type batchMsg []Cmd
 
+// bellMsg is the internal message for ringing the bell.
+type bellMsg struct{}
+
+// flashEndMsg is the internal message for ending a visual bell.
+type flashEndMsg struct{}
+
+// BellStyle is the way in which the bell is rung.
+type BellStyle int
+
+// Bell styles available.
+const (
+	// BellAudible writes the BEL character to the terminal.
+	BellAudible BellStyle = iota
+
+	// BellVisual briefly flashes the terminal.
+	BellVisual
+
+	// BellDisabled ignores the bell.
+	BellDisabled
+)
+
+// WindowSizeMsg is sent to your program's Update() function when the
+// program starts, and whenever the terminal is resized.
+type WindowSizeMsg struct {
+	// Width is the number of columns.
+	Width int
+
+	// Height is the number of rows.
+	Height int
+}
+
 // Msg is passed to your program's Update() function, representing an
 // action which was performed, for example a ItemRemoved msg might be
 // a struct containing the ID of the item removed.
@@ -64,6 +99,12 @@ func Quit(ctx context.Context) Msg {
 	return quitMsg{}
 }
 
+// Bell is a command which rings the bell, for example when
+// the user attempts to move the cursor past the end of the input.
+func Bell(ctx context.Context) Msg {
+	return bellMsg{}
+}
+
 // Batch performs many commands concurrently,
 // with no order guarantees.
 func Batch(cmds ...Cmd) Cmd {
@@ -84,7 +125,20 @@ type Program struct {
 	// View function.
 	View
 
+	// Bell is the style of the bell, defaulting to BellAudible.
+	Bell BellStyle
+
 	rw io.ReadWriter
+
+	// tty used to query the terminal size.
+	tty *os.File
+
+	// width and height of the terminal, or 0 when unknown.
+	width  int
+	height int
+
+	// frame is the previously rendered frame.
+	frame string
 }
 
 // NewProgram returns a new program.
@@ -105,6 +159,13 @@ func (p *Program) Start(ctx context.Context) error {
 	}
 	p.rw = tty
 
+	// terminal size
+	if f, err := os.Open("/dev/tty"); err == nil {
+		p.tty = f
+		defer f.Close()
+		p.width, p.height, _ = terminalSize(f)
+	}
+
 	// raw mode
 	tty.SetRaw()
 	defer tty.Restore()
@@ -123,20 +184,33 @@ func (p *Program) start(ctx context.Context) error {
 	done := make(chan struct{})
 	errs := make(chan error)
 
-	// input loop. We read user input and provide
-	// them to the application as msgs.
+	// input loop. We read user input and terminal
+	// responses, providing them to the application as msgs.
 	go func() {
+		var buf [256]byte
+		var osc []byte
+
 		for {
 			select {
 			case <-done:
 				return
 			default:
-				msg, err := terminput.Read(p.rw)
+				n, err := p.rw.Read(buf[:])
 				if err != nil {
 					errs <- err
 					return
 				}
-				msgs <- msg
+
+				var input []Msg
+				input, osc, err = parseInput(buf[:n], osc)
+				if err != nil {
+					errs <- err
+					return
+				}
+
+				for _, msg := range input {
+					msgs <- msg
+				}
 			}
 		}
 	}()
@@ -159,13 +233,39 @@ func (p *Program) start(ctx context.Context) error {
 		}
 	}()
 
+	// resize loop. We notify the application of the
+	// initial terminal size and any changes as msgs.
+	if p.tty != nil {
+		go func() {
+			sigs := make(chan os.Signal, 1)
+			signal.Notify(sigs, syscall.SIGWINCH)
+			defer signal.Stop(sigs)
+
+			for {
+				w, h, err := terminalSize(p.tty)
+				if err == nil {
+					select {
+					case <-done:
+						return
+					case msgs <- WindowSizeMsg{Width: w, Height: h}:
+					}
+				}
+
+				select {
+				case <-done:
+					return
+				case <-sigs:
+				}
+			}
+		}()
+	}
+
 	// initialize app
 	model, cmd := p.Init(ctx)
 	cmds <- cmd
 
 	// draw the initial view
-	prev := normalize(p.View(ctx, model))
-	io.WriteString(p.rw, prev)
+	p.render(p.View(ctx, model))
 
 	// draw loop. We process msgs, passing them
 	// to the Update() function followed by the
@@ -196,22 +296,111 @@ func (p *Program) start(ctx context.Context) error {
 				continue
 			}
 
+			// bell msg
+			if _, ok := msg.(bellMsg); ok {
+				switch p.Bell {
+				case BellAudible:
+					io.WriteString(p.rw, "\a")
+				case BellVisual:
+					io.WriteString(p.rw, "\033[?5h")
+					cmds <- flashEnd
+				}
+				continue
+			}
+
+			// set clipboard msg
+			if v, ok := msg.(setClipboardMsg); ok {
+				io.WriteString(p.rw, clipboardSequence(string(v)))
+				continue
+			}
+
+			// read clipboard msg
+			if _, ok := msg.(readClipboardMsg); ok {
+				io.WriteString(p.rw, osc52+"c;?\a")
+				continue
+			}
+
+			// flash end msg
+			if _, ok := msg.(flashEndMsg); ok {
+				io.WriteString(p.rw, "\033[?5l")
+				continue
+			}
+
+			// window size msg
+			if v, ok := msg.(WindowSizeMsg); ok {
+				p.width = v.Width
+				p.height = v.Height
+			}
+
 			// update
 			model, cmd = p.Update(ctx, msg, model)
 			cmds <- cmd
 
 			// render view changes
-			curr := normalize(p.View(ctx, model))
-			clearLines(strings.Count(prev, "\r\n"))
-			io.WriteString(p.rw, curr)
-			prev = curr
+			p.render(p.View(ctx, model))
 		}
 	}
 }
 
-// normalize .
-func normalize(s string) string {
-	return strings.Replace(s, "\n", "\r\n", -1)
+// render a view, replacing the previously rendered frame. Lines wider than
+// the terminal are truncated and frames taller than the terminal are clipped
+// to their last lines, so the cursor can always reach the start of the frame
+// without corrupting the scrollback of inline programs.
+func (p *Program) render(view string) {
+	rows := strings.Split(view, "\n")
+
+	// clip to the terminal height
+	if p.height > 0 && len(rows) > p.height {
+		rows = rows[len(rows)-p.height:]
+	}
+
+	// truncate to the terminal width
+	for i, row := range rows {
+		row = strings.TrimSuffix(row, "\r")
+		if p.width > 0 {
+			row = text.Truncate(row, p.width, "")
+		}
+		rows[i] = row
+	}
+
+	frame := strings.Join(rows, "\r\n")
+
+	// move to the start of the previous frame, which
+	// may have been re-wrapped if the terminal was resized
+	var b strings.Builder
+	n := lines(p.frame, p.width) - 1
+	if p.height > 0 && n > p.height-1 {
+		n = p.height - 1
+	}
+	if n > 0 {
+		moveUp(&b, n)
+	} else {
+		b.WriteString("\r")
+	}
+
+	// replace it
+	clearDown(&b)
+	b.WriteString(frame)
+	io.WriteString(p.rw, b.String())
+	p.frame = frame
+}
+
+// lines returns the number of terminal lines occupied by s, accounting
+// for lines wrapped by the terminal when the width is known.
+func lines(s string, width int) (n int) {
+	for _, line := range strings.Split(s, "\r\n") {
+		n++
+		if w := text.Width(line); width > 0 && w > width {
+			n += (w - 1) / width
+		}
+	}
+	return
+}
+
+// flashEnd is a command which ends a visual bell.
+func flashEnd(ctx context.Context) Msg {
+	time.Sleep(100 * time.Millisecond)
+	return flashEndMsg{}
 }
 
 // hideCursor hides the cursor.
@@ -224,22 +413,14 @@ func showCursor() {
 	fmt.Printf("\033[?25h")
 }
 
-// clearLines clears a number of lines.
-func clearLines(n int) {
-	for i := 0; i < n; i++ {
-		moveUp(1)
-		clearLine()
-	}
-}
-
-// clearLine clears the entire line.
-func clearLine() {
-	fmt.Printf("\033[2K")
+// clearDown clears from the cursor to the end of the screen.
+func clearDown(w io.Writer) {
+	fmt.Fprintf(w, "\033[J")
 }
 
 // moveUp moves the cursor to the beginning of n lines up.
-func moveUp(n int) {
-	fmt.Printf("\033[%dF", n)
+func moveUp(w io.Writer, n int) {
+	fmt.Fprintf(w, "\033[%dF", n)
 }
 
 // clear the screen.
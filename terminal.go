@@ -0,0 +1,21 @@
+package tea
+
+import (
+	"os"
+	"syscall"
+	"unsafe"
+)
+
+// terminalSize returns the width and height of the terminal.
+func terminalSize(f *os.File) (width, height int, err error) {
+	var ws struct {
+		Row, Col, X, Y uint16
+	}
+
+	_, _, errno := syscall.Syscall(syscall.SYS_IOCTL, f.Fd(), uintptr(syscall.TIOCGWINSZ), uintptr(unsafe.Pointer(&ws)))
+	if errno != 0 {
+		return 0, 0, errno
+	}
+
+	return int(ws.Col), int(ws.Row), nil
+}
@@ -0,0 +1,92 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// zwj is the zero width joiner.
+const zwj = '\u200d'
+
+// Graphemes splits s into user-perceived characters, such as a letter
+// followed by combining accents, an emoji with skin tone modifiers,
+// an emoji zero width joiner sequence, or a pair of regional indicators.
+func Graphemes(s string) (clusters []string) {
+	start := 0
+	prev := rune(-1)
+	regional := 0
+
+	for i, r := range s {
+		if i > start && boundary(prev, r, regional) {
+			clusters = append(clusters, s[start:i])
+			start = i
+			regional = 0
+		}
+		if isRegional(r) {
+			regional++
+		}
+		prev = r
+	}
+
+	if start < len(s) {
+		clusters = append(clusters, s[start:])
+	}
+
+	return
+}
+
+// GraphemeWidth returns the number of columns occupied by a grapheme cluster.
+func GraphemeWidth(g string) int {
+	r, size := utf8.DecodeRuneInString(g)
+	w := RuneWidth(r)
+
+	switch {
+	case w == 0:
+		return 0
+	case isRegional(r) && len(g) > size:
+		return 2
+	case w == 1 && strings.ContainsRune(g[size:], '\ufe0f'):
+		return 2
+	default:
+		return w
+	}
+}
+
+// boundary returns true if a grapheme cluster boundary exists between prev and r,
+// where regional is the number of regional indicators in the current cluster.
+func boundary(prev, r rune, regional int) bool {
+	switch {
+	case prev == '\r' && r == '\n':
+		return false
+	case prev < 0x20 || prev == 0x7f:
+		return true
+	case r < 0x20 || r == 0x7f:
+		return true
+	case r == zwj || isExtend(r):
+		return false
+	case prev == zwj:
+		return false
+	case isRegional(prev) && isRegional(r):
+		return regional%2 == 0
+	default:
+		return true
+	}
+}
+
+// isExtend returns true for characters which extend the preceding character.
+func isExtend(r rune) bool {
+	switch {
+	case r >= 0x1F3FB && r <= 0x1F3FF:
+		return true
+	case r >= 0xE0020 && r <= 0xE007F:
+		return true
+	default:
+		return unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+	}
+}
+
+// isRegional returns true for regional indicator symbols used in flags.
+func isRegional(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
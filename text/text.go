@@ -0,0 +1,295 @@
+// Package text provides display width aware utilities for terminal text,
+// accounting for wide and combining characters and ignoring escape sequences.
+package text
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Width returns the number of columns occupied by s, ignoring escape sequences.
+// For multi-line strings the width of the widest line is returned.
+func Width(s string) (max int) {
+	var n int
+	segments(s, func(seg string, esc bool) bool {
+		switch {
+		case esc:
+		case seg == "\n":
+			n = 0
+		default:
+			n += GraphemeWidth(seg)
+		}
+		if n > max {
+			max = n
+		}
+		return true
+	})
+	return
+}
+
+// Strip returns s with escape sequences removed.
+func Strip(s string) string {
+	if !strings.Contains(s, "\033") {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		if e := escape(s[i:]); e > 0 {
+			i += e
+			continue
+		}
+		b.WriteByte(s[i])
+		i++
+	}
+	return b.String()
+}
+
+// Truncate a single line to at most w columns, appending tail such as "…"
+// when truncated. Escape sequences are preserved, with styling reset after
+// the truncation point.
+func Truncate(s string, w int, tail string) string {
+	if Width(s) <= w {
+		return s
+	}
+
+	tw := Width(tail)
+	if tw > w {
+		tail, tw = "", 0
+	}
+
+	var b strings.Builder
+	var n int
+	var styled bool
+
+	segments(s, func(seg string, esc bool) bool {
+		if esc {
+			b.WriteString(seg)
+			styled = true
+			return true
+		}
+
+		gw := GraphemeWidth(seg)
+		if n+gw > w-tw {
+			return false
+		}
+		b.WriteString(seg)
+		n += gw
+		return true
+	})
+
+	if styled {
+		b.WriteString("\033[0m")
+	}
+
+	b.WriteString(tail)
+	return b.String()
+}
+
+// Cut returns the columns of a single line from column from up to column to.
+// Escape sequences are preserved, with styling reset after the cut, and wide
+// characters split by either end are replaced with spaces.
+func Cut(s string, from, to int) string {
+	var b strings.Builder
+	var n int
+	var styled bool
+
+	segments(s, func(seg string, esc bool) bool {
+		if esc {
+			b.WriteString(seg)
+			styled = true
+			return true
+		}
+
+		gw := GraphemeWidth(seg)
+		start, end := n, n+gw
+		n = end
+
+		switch {
+		case end <= from:
+		case start >= to:
+			return false
+		case start < from:
+			if end > to {
+				end = to
+			}
+			b.WriteString(strings.Repeat(" ", end-from))
+		case end > to:
+			b.WriteString(strings.Repeat(" ", to-start))
+		default:
+			b.WriteString(seg)
+		}
+		return true
+	})
+
+	if styled {
+		b.WriteString("\033[0m")
+	}
+
+	return b.String()
+}
+
+// PadRight pads s with spaces on the right to w columns.
+func PadRight(s string, w int) string {
+	if n := Width(s); n < w {
+		return s + strings.Repeat(" ", w-n)
+	}
+	return s
+}
+
+// PadLeft pads s with spaces on the left to w columns.
+func PadLeft(s string, w int) string {
+	if n := Width(s); n < w {
+		return strings.Repeat(" ", w-n) + s
+	}
+	return s
+}
+
+// Center pads s with spaces on both sides to w columns.
+func Center(s string, w int) string {
+	n := Width(s)
+	if n >= w {
+		return s
+	}
+	gap := w - n
+	return strings.Repeat(" ", gap/2) + s + strings.Repeat(" ", gap-gap/2)
+}
+
+// Wrap s to lines of at most w columns, breaking on whitespace where possible
+// and breaking words which are wider than w. Existing newlines, indentation
+// and the whitespace between words are preserved, while the whitespace where
+// a line is broken, and trailing whitespace of lines which are wrapped, is dropped.
+func Wrap(s string, w int) string {
+	if w <= 0 {
+		return s
+	}
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = wrapLine(line, w)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// wrapLine wraps a single line, keeping the whitespace before each word
+// except where the line is broken, including indentation which fits
+// with the first word.
+func wrapLine(s string, w int) string {
+	if Width(s) <= w {
+		return s
+	}
+
+	var lines []string
+	var line string
+	var n int
+
+	for _, f := range fields(s) {
+		gap, word := f[0], f[1]
+
+		// whitespace where a line is broken is dropped
+		if n == 0 && len(lines) > 0 {
+			gap = ""
+		}
+
+		gw, ww := Width(gap), Width(word)
+		if n+gw+ww <= w {
+			line += gap + word
+			n += gw + ww
+			continue
+		}
+
+		if n > 0 {
+			lines = append(lines, line)
+			line, n = "", 0
+		}
+
+		// break long words
+		for n+ww > w {
+			head, rest := split(word, w)
+			lines = append(lines, head)
+			word, ww = rest, Width(rest)
+		}
+
+		line += word
+		n += ww
+	}
+
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// fields returns the words of s, each paired with the whitespace before it.
+// Trailing whitespace is dropped.
+func fields(s string) (words [][2]string) {
+	for s != "" {
+		i := strings.IndexFunc(s, func(r rune) bool { return !unicode.IsSpace(r) })
+		if i < 0 {
+			break
+		}
+		j := strings.IndexFunc(s[i:], unicode.IsSpace)
+		if j < 0 {
+			j = len(s) - i
+		}
+		words = append(words, [2]string{s[:i], s[i : i+j]})
+		s = s[i+j:]
+	}
+	return
+}
+
+// split s at w columns.
+func split(s string, w int) (head, tail string) {
+	var n, i int
+	segments(s, func(seg string, esc bool) bool {
+		if !esc {
+			gw := GraphemeWidth(seg)
+			if n+gw > w && n > 0 {
+				return false
+			}
+			n += gw
+		}
+		i += len(seg)
+		return true
+	})
+	return s[:i], s[i:]
+}
+
+// segments calls fn for each escape sequence and grapheme cluster
+// in s, until fn returns false.
+func segments(s string, fn func(seg string, esc bool) bool) {
+	for i := 0; i < len(s); {
+		if e := escape(s[i:]); e > 0 {
+			if !fn(s[i:i+e], true) {
+				return
+			}
+			i += e
+			continue
+		}
+
+		j := i + 1
+		for j < len(s) && escape(s[j:]) == 0 {
+			j++
+		}
+
+		for _, g := range Graphemes(s[i:j]) {
+			if !fn(g, false) {
+				return
+			}
+		}
+		i = j
+	}
+}
+
+// escape returns the length of the CSI escape sequence at the start of s, or 0.
+func escape(s string) int {
+	if len(s) < 2 || s[0] != '\033' || s[1] != '[' {
+		return 0
+	}
+	for i := 2; i < len(s); i++ {
+		if s[i] >= 0x40 && s[i] <= 0x7e {
+			return i + 1
+		}
+	}
+	return len(s)
+}
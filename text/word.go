@@ -0,0 +1,50 @@
+package text
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// WordLeft returns the number of grapheme clusters from pos to the start of the previous word.
+func WordLeft(g []string, pos int) (size int) {
+	i := pos - 1
+
+	// skip whitespace
+	for i >= 0 && IsSpace(g[i]) {
+		size++
+		i--
+	}
+
+	// skip word
+	for i >= 0 && !IsSpace(g[i]) {
+		size++
+		i--
+	}
+
+	return
+}
+
+// WordRight returns the number of grapheme clusters from pos to the start of the next word.
+func WordRight(g []string, pos int) (size int) {
+	i := pos
+
+	// skip word
+	for i < len(g) && !IsSpace(g[i]) {
+		size++
+		i++
+	}
+
+	// skip whitespace
+	for i < len(g) && IsSpace(g[i]) {
+		size++
+		i++
+	}
+
+	return
+}
+
+// IsSpace returns true if the grapheme cluster is whitespace.
+func IsSpace(g string) bool {
+	r, _ := utf8.DecodeRuneInString(g)
+	return unicode.IsSpace(r)
+}
@@ -0,0 +1,83 @@
+package textarea
+
+// KeyMap is a set of key bindings, using key names such as "ctrl+a".
+type KeyMap struct {
+	// CharacterLeft moves the cursor one character left, or to the end of the previous line.
+	CharacterLeft []string
+
+	// CharacterRight moves the cursor one character right, or to the start of the next line.
+	CharacterRight []string
+
+	// WordLeft moves the cursor to the start of the previous word.
+	WordLeft []string
+
+	// WordRight moves the cursor to the start of the next word.
+	WordRight []string
+
+	// LineUp moves the cursor up one row, keeping the preferred column.
+	LineUp []string
+
+	// LineDown moves the cursor down one row, keeping the preferred column.
+	LineDown []string
+
+	// PageUp moves the cursor up one page.
+	PageUp []string
+
+	// PageDown moves the cursor down one page.
+	PageDown []string
+
+	// LineStart moves the cursor to the start of the line.
+	LineStart []string
+
+	// LineEnd moves the cursor to the end of the line.
+	LineEnd []string
+
+	// InsertNewline splits the line at the cursor.
+	InsertNewline []string
+
+	// DeleteCharacterBackward deletes the character before the cursor,
+	// or joins the line with the previous line.
+	DeleteCharacterBackward []string
+
+	// DeleteCharacterForward deletes the character under the cursor,
+	// or joins the line with the next line.
+	DeleteCharacterForward []string
+
+	// DeleteWordBackward deletes the word before the cursor.
+	DeleteWordBackward []string
+
+	// DeleteAfterCursor deletes the text from the cursor to the end of
+	// the line, or joins the line with the next line.
+	DeleteAfterCursor []string
+
+	// DeleteBeforeCursor deletes the text from the start of the line to the cursor.
+	DeleteBeforeCursor []string
+
+	// Undo reverts the most recent edit, consecutive typing is undone together.
+	Undo []string
+
+	// Redo reapplies the most recently undone edit.
+	Redo []string
+}
+
+// DefaultKeyMap is the default set of key bindings.
+var DefaultKeyMap = KeyMap{
+	CharacterLeft:           []string{"left", "ctrl+b"},
+	CharacterRight:          []string{"right", "ctrl+f"},
+	WordLeft:                []string{"alt+left", "alt+b"},
+	WordRight:               []string{"alt+right", "alt+f"},
+	LineUp:                  []string{"up", "ctrl+p"},
+	LineDown:                []string{"down", "ctrl+n"},
+	PageUp:                  []string{"pgup"},
+	PageDown:                []string{"pgdown"},
+	LineStart:               []string{"home", "ctrl+a"},
+	LineEnd:                 []string{"end", "ctrl+e"},
+	InsertNewline:           []string{"enter"},
+	DeleteCharacterBackward: []string{"backspace"},
+	DeleteCharacterForward:  []string{"delete"},
+	DeleteWordBackward:      []string{"ctrl+w", "alt+backspace"},
+	DeleteAfterCursor:       []string{"ctrl+k"},
+	DeleteBeforeCursor:      []string{"ctrl+u"},
+	Undo:                    []string{"ctrl+z", "ctrl+_"},
+	Redo:                    []string{"ctrl+^"},
+}
@@ -0,0 +1,506 @@
+// Package textarea provides a multi-line text input.
+package textarea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/tj/go-tea"
+	"github.com/tj/go-tea/internal/cursor"
+	"github.com/tj/go-tea/key"
+	"github.com/tj/go-tea/text"
+	"github.com/tj/go-tea/theme"
+	"github.com/tj/go-tea/undo"
+	"github.com/tj/go-tea/viewport"
+	"github.com/tj/go-terminput"
+)
+
+// Model is the textarea model.
+type Model struct {
+	// Value is the text, with lines separated by "\n".
+	Value string
+
+	// Placeholder is the text rendered when the value is empty.
+	Placeholder string
+
+	// Width is the number of columns used to display the text, excluding line
+	// numbers. Lines which do not fit are soft wrapped. Defaults to no wrapping.
+	Width int
+
+	// MaxHeight is the maximum number of rows displayed, the text is scrolled
+	// vertically to keep the cursor in view. Defaults to displaying all rows.
+	MaxHeight int
+
+	// ShowLineNumbers displays the line number before each line.
+	ShowLineNumbers bool
+
+	// CharLimit is the maximum number of characters, including newlines, or 0 for no limit.
+	CharLimit int
+
+	// KeyMap is the set of key bindings. Defaults to DefaultKeyMap.
+	KeyMap *KeyMap
+
+	// line is the line of the cursor.
+	line int
+
+	// col is the position of the cursor in the line, in grapheme clusters.
+	col int
+
+	// goal is the preferred column of the cursor when moving vertically.
+	goal int
+
+	// vertical is true when the previous key moved the cursor vertically.
+	vertical bool
+
+	// viewport of the visible rows.
+	viewport viewport.Model
+
+	// edits is the undo and redo history.
+	edits undo.Stack
+}
+
+// Cursor returns the line and column of the cursor, in grapheme clusters.
+func (m *Model) Cursor() (line, column int) {
+	return m.line, m.col
+}
+
+// LineCount returns the number of lines.
+func (m *Model) LineCount() int {
+	return strings.Count(m.Value, "\n") + 1
+}
+
+// Update function.
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	k, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	km := m.KeyMap
+	if km == nil {
+		km = &DefaultKeyMap
+	}
+
+	lines := split(m.Value)
+	m.line = clamp(m.line, 0, len(lines)-1)
+	m.col = clamp(m.col, 0, len(lines[m.line]))
+	vertical := m.vertical
+	m.vertical = false
+
+	g := lines[m.line]
+	last := len(lines) - 1
+
+	prev := m.Value
+	before := undo.State{Text: m.Value, Pos: offset(lines, m.line, m.col)}
+	kind := undo.Change
+	var undoing bool
+
+	var cmd tea.Cmd
+
+	switch {
+	case key.Matches(k, km.Undo...):
+		s, ok := m.edits.Undo(before)
+		if !ok {
+			return m, tea.Bell
+		}
+		lines = split(s.Text)
+		m.line, m.col = position(lines, s.Pos)
+		undoing = true
+	case key.Matches(k, km.Redo...):
+		s, ok := m.edits.Redo(before)
+		if !ok {
+			return m, tea.Bell
+		}
+		lines = split(s.Text)
+		m.line, m.col = position(lines, s.Pos)
+		undoing = true
+	case key.Matches(k, km.LineUp...):
+		m, cmd = move(m, lines, -1, vertical)
+	case key.Matches(k, km.LineDown...):
+		m, cmd = move(m, lines, 1, vertical)
+	case key.Matches(k, km.PageUp...):
+		m, cmd = move(m, lines, -page(m), vertical)
+	case key.Matches(k, km.PageDown...):
+		m, cmd = move(m, lines, page(m), vertical)
+	case key.Matches(k, km.CharacterLeft...):
+		switch {
+		case m.col > 0:
+			m.col--
+		case m.line > 0:
+			m.line--
+			m.col = len(lines[m.line])
+		default:
+			return m, tea.Bell
+		}
+	case key.Matches(k, km.CharacterRight...):
+		switch {
+		case m.col < len(g):
+			m.col++
+		case m.line < last:
+			m.line++
+			m.col = 0
+		default:
+			return m, tea.Bell
+		}
+	case key.Matches(k, km.WordLeft...):
+		switch {
+		case m.col > 0:
+			m.col -= text.WordLeft(g, m.col)
+		case m.line > 0:
+			m.line--
+			m.col = len(lines[m.line])
+		default:
+			return m, tea.Bell
+		}
+	case key.Matches(k, km.WordRight...):
+		switch {
+		case m.col < len(g):
+			m.col += text.WordRight(g, m.col)
+		case m.line < last:
+			m.line++
+			m.col = 0
+		default:
+			return m, tea.Bell
+		}
+	case key.Matches(k, km.LineStart...):
+		m.col = 0
+	case key.Matches(k, km.LineEnd...):
+		m.col = len(g)
+	case key.Matches(k, km.InsertNewline...):
+		if m.CharLimit > 0 && length(lines)+1 > m.CharLimit {
+			return m, tea.Bell
+		}
+		lines = append(lines, nil)
+		copy(lines[m.line+2:], lines[m.line+1:])
+		lines[m.line] = g[:m.col:m.col]
+		lines[m.line+1] = g[m.col:]
+		m.line++
+		m.col = 0
+	case key.Matches(k, km.DeleteCharacterBackward...):
+		switch {
+		case m.col > 0:
+			lines[m.line] = concat(g[:m.col-1], g[m.col:])
+			m.col--
+		case m.line > 0:
+			m.col = len(lines[m.line-1])
+			lines = joinLine(lines, m.line-1)
+			m.line--
+		default:
+			return m, tea.Bell
+		}
+		kind = undo.Delete
+	case key.Matches(k, km.DeleteCharacterForward...):
+		switch {
+		case m.col < len(g):
+			lines[m.line] = concat(g[:m.col], g[m.col+1:])
+		case m.line < last:
+			lines = joinLine(lines, m.line)
+		default:
+			return m, tea.Bell
+		}
+		kind = undo.Delete
+	case key.Matches(k, km.DeleteWordBackward...):
+		switch {
+		case m.col > 0:
+			n := text.WordLeft(g, m.col)
+			lines[m.line] = concat(g[:m.col-n], g[m.col:])
+			m.col -= n
+		case m.line > 0:
+			m.col = len(lines[m.line-1])
+			lines = joinLine(lines, m.line-1)
+			m.line--
+		default:
+			return m, tea.Bell
+		}
+	case key.Matches(k, km.DeleteAfterCursor...):
+		switch {
+		case m.col < len(g):
+			lines[m.line] = g[:m.col]
+		case m.line < last:
+			lines = joinLine(lines, m.line)
+		default:
+			return m, tea.Bell
+		}
+	case key.Matches(k, km.DeleteBeforeCursor...):
+		if m.col == 0 {
+			return m, tea.Bell
+		}
+		lines[m.line] = g[m.col:]
+		m.col = 0
+	case k.Key() == terminput.KeyRune && !k.Alt() && !unicode.IsControl(k.Rune()):
+		if m.CharLimit > 0 && length(lines)+1 > m.CharLimit {
+			return m, tea.Bell
+		}
+		s := join(g[:m.col]) + string(k.Rune())
+		lines[m.line] = text.Graphemes(s + join(g[m.col:]))
+		m.col = len(text.Graphemes(s))
+		kind = undo.Insert
+	}
+
+	m.Value = joinLines(lines)
+
+	switch {
+	case undoing:
+	case m.Value != prev:
+		m.edits.Push(before, kind)
+	default:
+		m.edits.Break()
+	}
+	m.viewport = scroll(m, lines)
+	return m, cmd
+}
+
+// View function.
+func View(m Model) string {
+	t := theme.Current()
+	lines := split(m.Value)
+	m.line = clamp(m.line, 0, len(lines)-1)
+	m.col = clamp(m.col, 0, len(lines[m.line]))
+	m.viewport = scroll(m, lines)
+
+	gutter := len(strconv.Itoa(len(lines)))
+	number := func(n int) string {
+		if !m.ShowLineNumbers {
+			return ""
+		}
+		if n == 0 {
+			return strings.Repeat(" ", gutter+1)
+		}
+		return t.Muted.Render(fmt.Sprintf("%*d", gutter, n)) + " "
+	}
+
+	// placeholder
+	if m.Value == "" && m.Placeholder != "" {
+		p := m.Placeholder
+		if m.Width > 0 {
+			p = text.Truncate(p, m.Width, "")
+		}
+		g := text.Graphemes(p)
+		return number(1) + cursor.Render(g[0]) + t.Muted.Render(join(g[1:]))
+	}
+
+	var b strings.Builder
+	for _, r := range rows(lines, m.Width) {
+		if r.start == 0 {
+			b.WriteString(number(r.line + 1))
+		} else {
+			b.WriteString(number(0))
+		}
+
+		g := lines[r.line]
+		if r.line == m.line && m.col >= r.start && (m.col < r.end || r.last) {
+			b.WriteString(cursor.Line(g, r.start, r.end, m.col))
+		} else {
+			b.WriteString(join(g[r.start:r.end]))
+		}
+		b.WriteString("\n")
+	}
+
+	m.viewport.Content = b.String()
+	return viewport.View(m.viewport)
+}
+
+// row is a displayed row of a line, from the start to end grapheme cluster.
+type row struct {
+	line  int
+	start int
+	end   int
+	last  bool
+}
+
+// rows returns the rows of the lines soft wrapped to w columns, breaking after
+// whitespace where possible. A line which fills its last row is followed by an
+// empty row, so the cursor has a cell at the end of the line.
+func rows(lines [][]string, w int) (out []row) {
+	for i, g := range lines {
+		if w <= 0 {
+			out = append(out, row{line: i, start: 0, end: len(g), last: true})
+			continue
+		}
+
+		start, n, brk := 0, 0, -1
+		for j := 0; j < len(g); j++ {
+			gw := text.GraphemeWidth(g[j])
+
+			if n+gw > w && j > start {
+				end := j
+				if brk > start {
+					end = brk
+				}
+				out = append(out, row{line: i, start: start, end: end})
+				start, brk = end, -1
+				n = text.Width(join(g[start:j]))
+			}
+
+			n += gw
+			if text.IsSpace(g[j]) {
+				brk = j + 1
+			}
+		}
+
+		if n >= w {
+			out = append(out, row{line: i, start: start, end: len(g)})
+			start = len(g)
+		}
+
+		out = append(out, row{line: i, start: start, end: len(g), last: true})
+	}
+	return
+}
+
+// index returns the index of the row containing the cursor.
+func index(rs []row, line, col int) int {
+	for i, r := range rs {
+		if r.line == line && col >= r.start && (col < r.end || r.last) {
+			return i
+		}
+	}
+	return 0
+}
+
+// move the cursor by n rows, keeping the preferred column
+// when the previous key also moved the cursor vertically.
+func move(m Model, lines [][]string, n int, vertical bool) (Model, tea.Cmd) {
+	rs := rows(lines, m.Width)
+	i := index(rs, m.line, m.col)
+	r := rs[i]
+
+	if !vertical {
+		m.goal = text.Width(join(lines[r.line][r.start:m.col]))
+	}
+	m.vertical = true
+
+	to := clamp(i+n, 0, len(rs)-1)
+	if to == i {
+		return m, tea.Bell
+	}
+
+	r = rs[to]
+	g := lines[r.line]
+
+	end := r.end
+	if !r.last {
+		end--
+	}
+
+	col, w := r.start, 0
+	for col < end {
+		gw := text.GraphemeWidth(g[col])
+		if w+gw > m.goal {
+			break
+		}
+		w += gw
+		col++
+	}
+
+	m.line, m.col = r.line, col
+	return m, nil
+}
+
+// offset returns the position of the line and column in grapheme
+// clusters from the start of the text, counting newlines.
+func offset(lines [][]string, line, col int) (n int) {
+	for _, g := range lines[:line] {
+		n += len(g) + 1
+	}
+	return n + col
+}
+
+// position returns the line and column of an offset from offset().
+func position(lines [][]string, n int) (line, col int) {
+	for line < len(lines)-1 && n > len(lines[line]) {
+		n -= len(lines[line]) + 1
+		line++
+	}
+	return line, clamp(n, 0, len(lines[line]))
+}
+
+// page returns the number of rows moved by a page.
+func page(m Model) int {
+	return max(1, m.viewport.Height-1)
+}
+
+// scroll returns the viewport of the visible rows, scrolled to keep the cursor in view.
+func scroll(m Model, lines [][]string) viewport.Model {
+	rs := rows(lines, m.Width)
+	vp := m.viewport
+
+	vp.Height = len(rs)
+	if m.MaxHeight > 0 && vp.Height > m.MaxHeight {
+		vp.Height = m.MaxHeight
+	}
+
+	i := index(rs, m.line, m.col)
+	if i < vp.ScrollY {
+		vp.ScrollY = i
+	}
+	if i >= vp.ScrollY+vp.Height {
+		vp.ScrollY = i - vp.Height + 1
+	}
+	vp.ScrollY = clamp(vp.ScrollY, 0, len(rs)-vp.Height)
+
+	return vp
+}
+
+// split the value into lines of grapheme clusters.
+func split(s string) (lines [][]string) {
+	for _, line := range strings.Split(s, "\n") {
+		lines = append(lines, text.Graphemes(line))
+	}
+	return
+}
+
+// joinLines joins lines of grapheme clusters into a value.
+func joinLines(lines [][]string) string {
+	s := make([]string, len(lines))
+	for i, g := range lines {
+		s[i] = join(g)
+	}
+	return strings.Join(s, "\n")
+}
+
+// joinLine joins line i with the following line.
+func joinLine(lines [][]string, i int) [][]string {
+	lines[i] = concat(lines[i], lines[i+1])
+	return append(lines[:i+1], lines[i+2:]...)
+}
+
+// length returns the number of characters, including newlines.
+func length(lines [][]string) int {
+	n := len(lines) - 1
+	for _, g := range lines {
+		n += len(g)
+	}
+	return n
+}
+
+// concat returns a new slice of a followed by b.
+func concat(a, b []string) []string {
+	return append(append([]string{}, a...), b...)
+}
+
+// join grapheme clusters.
+func join(g []string) string {
+	return strings.Join(g, "")
+}
+
+// max returns the maximum of two ints.
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// clamp n between min and max.
+func clamp(n, min, max int) int {
+	if n > max {
+		n = max
+	}
+	if n < min {
+		n = min
+	}
+	return n
+}
@@ -0,0 +1,140 @@
+// Package theme provides the styles and glyphs used by the bundled components,
+// allowing every component to be restyled at once.
+package theme
+
+import (
+	"github.com/tj/go-tea/style"
+)
+
+// Theme is a set of named styles and glyphs.
+type Theme struct {
+	// Selected is the style of selected or active items.
+	Selected style.Style
+
+	// Cursor is the style of the text input cursor.
+	Cursor style.Style
+
+	// Muted is the style of secondary text such as hints.
+	Muted style.Style
+
+	// Error is the style of errors and destructive actions.
+	Error style.Style
+
+	// Success is the style of successful or completed states.
+	Success style.Style
+
+	// Accent is the style used to draw attention to text.
+	Accent style.Style
+
+	// Glyphs is the set of glyphs used.
+	Glyphs Glyphs
+}
+
+// Glyphs is a set of glyphs used by components.
+type Glyphs struct {
+	// Checked is the glyph of a selected option.
+	Checked string
+
+	// Unchecked is the glyph of an unselected option.
+	Unchecked string
+
+	// BarFilled is the glyph of the filled portion of a progress bar.
+	BarFilled string
+
+	// BarEmpty is the glyph of the empty portion of a progress bar.
+	BarEmpty string
+
+	// StepCompleted is the glyph of a completed step.
+	StepCompleted string
+
+	// Step is the glyph of a step.
+	Step string
+
+	// StepBarCompleted is the glyph of the completed portion of the steps bar.
+	StepBarCompleted string
+
+	// StepBar is the glyph of the uncompleted portion of the steps bar.
+	StepBar string
+
+	// Spinner is the set of spinner frames.
+	Spinner []string
+
+	// MoreAbove is the glyph indicating a list has items scrolled above.
+	MoreAbove string
+
+	// MoreBelow is the glyph indicating a list has items scrolled below.
+	MoreBelow string
+}
+
+// Dark is a theme for terminals with a dark background.
+var Dark = Theme{
+	Selected: style.New().Bold(),
+	Cursor:   style.New().Background("61"),
+	Muted:    style.New().Foreground("243"),
+	Error:    style.New().Bold().Foreground(style.Red),
+	Success:  style.New().Foreground(style.Green),
+	Accent:   style.New().Bold().Foreground("141"),
+	Glyphs:   unicodeGlyphs,
+}
+
+// Light is a theme for terminals with a light background.
+var Light = Theme{
+	Selected: style.New().Bold(),
+	Cursor:   style.New().Background("153"),
+	Muted:    style.New().Foreground("245"),
+	Error:    style.New().Bold().Foreground("160"),
+	Success:  style.New().Foreground("28"),
+	Accent:   style.New().Bold().Foreground("92"),
+	Glyphs:   unicodeGlyphs,
+}
+
+// ASCII is a colorless theme using only ASCII glyphs.
+var ASCII = Theme{
+	Selected: style.New().Bold(),
+	Cursor:   style.New().Reverse(),
+	Muted:    style.New().Faint(),
+	Error:    style.New().Bold(),
+	Success:  style.New(),
+	Accent:   style.New().Underline(),
+	Glyphs: Glyphs{
+		Checked:          "[x]",
+		Unchecked:        "[ ]",
+		BarFilled:        "#",
+		BarEmpty:         "-",
+		StepCompleted:    "*",
+		Step:             "o",
+		StepBarCompleted: "=",
+		StepBar:          "-",
+		Spinner:          []string{"|", "/", "-", "\\"},
+		MoreAbove:        "^",
+		MoreBelow:        "v",
+	},
+}
+
+// unicodeGlyphs is the default set of glyphs.
+var unicodeGlyphs = Glyphs{
+	Checked:          "■",
+	Unchecked:        "□",
+	BarFilled:        "█",
+	BarEmpty:         "░",
+	StepCompleted:    "◉",
+	Step:             "◯",
+	StepBarCompleted: "━",
+	StepBar:          "━",
+	Spinner:          []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"},
+	MoreAbove:        "↑",
+	MoreBelow:        "↓",
+}
+
+// current is the active theme.
+var current = Dark
+
+// Current returns the active theme.
+func Current() Theme {
+	return current
+}
+
+// Set the active theme.
+func Set(t Theme) {
+	current = t
+}
@@ -0,0 +1,118 @@
+// Package undo provides an undo and redo history for text editing components.
+package undo
+
+// DefaultMax is the default maximum number of undo steps.
+const DefaultMax = 100
+
+// State is a snapshot of the text and cursor position.
+type State struct {
+	// Text is the text.
+	Text string
+
+	// Pos is the position of the cursor in grapheme clusters.
+	Pos int
+}
+
+// Kind is the kind of an edit.
+type Kind int
+
+// Kinds available. Consecutive edits of the same kind, other
+// than Change, are grouped into a single undo step.
+const (
+	// Change is an edit which is never grouped, such as a kill or paste.
+	Change Kind = iota
+
+	// Insert is a typed character.
+	Insert
+
+	// Delete is a deleted character.
+	Delete
+)
+
+// Stack is an undo and redo history. The zero value is ready to use.
+type Stack struct {
+	// Max is the maximum number of undo steps. Defaults to DefaultMax.
+	Max int
+
+	undo []State
+	redo []State
+
+	// kind of the previous edit, used for grouping.
+	kind Kind
+}
+
+// Push records the state before an edit of the given kind,
+// unless the edit is grouped with the previous edit.
+func (s *Stack) Push(before State, kind Kind) {
+	grouped := kind != Change && kind == s.kind
+	s.kind = kind
+
+	if grouped {
+		return
+	}
+
+	s.undo = push(s.undo, before, s.max())
+	s.redo = nil
+}
+
+// Break ends the current group, for example when the cursor
+// is moved, so the next edit is recorded as a new step.
+func (s *Stack) Break() {
+	s.kind = Change
+}
+
+// Undo returns the state before the most recent step, recording
+// the current state so it may be redone, or false if there is none.
+func (s *Stack) Undo(current State) (State, bool) {
+	if len(s.undo) == 0 {
+		return current, false
+	}
+
+	prev := s.undo[len(s.undo)-1]
+	s.undo = s.undo[:len(s.undo)-1]
+	s.redo = push(s.redo, current, s.max())
+	s.kind = Change
+	return prev, true
+}
+
+// Redo returns the state after the most recently undone step, recording
+// the current state so it may be undone, or false if there is none.
+func (s *Stack) Redo(current State) (State, bool) {
+	if len(s.redo) == 0 {
+		return current, false
+	}
+
+	next := s.redo[len(s.redo)-1]
+	s.redo = s.redo[:len(s.redo)-1]
+	s.undo = push(s.undo, current, s.max())
+	s.kind = Change
+	return next, true
+}
+
+// CanUndo returns true if there is a step to undo.
+func (s *Stack) CanUndo() bool {
+	return len(s.undo) > 0
+}
+
+// CanRedo returns true if there is a step to redo.
+func (s *Stack) CanRedo() bool {
+	return len(s.redo) > 0
+}
+
+// max returns the maximum number of steps.
+func (s *Stack) max() int {
+	if s.Max <= 0 {
+		return DefaultMax
+	}
+	return s.Max
+}
+
+// push returns a copy of states with state appended, dropping the oldest
+// states exceeding max. States are copied as models are passed by value.
+func push(states []State, state State, max int) []State {
+	out := append(append([]State{}, states...), state)
+	if len(out) > max {
+		out = out[len(out)-max:]
+	}
+	return out
+}
@@ -2,53 +2,191 @@
 package viewport
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/tj/go-tea"
-	"github.com/tj/go-terminput"
+	"github.com/tj/go-tea/internal/cursor"
+	"github.com/tj/go-tea/key"
+	"github.com/tj/go-tea/text"
 )
 
 // Model is the viewport model.
 type Model struct {
+	// Content is the text displayed, from which the scrollable
+	// height and width are derived. A trailing newline is ignored.
+	Content string
+
 	// Height is the viewport height, usually the terminal height.
 	Height int
 
-	// ScrollHeight is the scrollable height.
-	ScrollHeight int
+	// Width is the viewport width, lines are clipped to it by display width.
+	// Defaults to displaying lines in full, without horizontal scrolling.
+	Width int
 
 	// ScrollY is the vertical scroll position.
 	ScrollY int
 
-	// ScrollBy is the number of rows or columns to scroll by.
+	// ScrollX is the horizontal scroll position, in columns.
+	ScrollX int
+
+	// ScrollBy is the number of rows or columns to scroll by. Defaults to 1.
 	ScrollBy int
+
+	// selecting is true when lines are being selected.
+	selecting bool
+
+	// anchor is the line where the selection started.
+	anchor int
+
+	// cursor is the line where the selection ends, moved with Up and Down.
+	cursor int
+}
+
+// ScrollHeight returns the number of lines of content,
+// not counting the empty line after a trailing newline.
+func (m *Model) ScrollHeight() int {
+	return len(lines(m.Content))
+}
+
+// ScrollWidth returns the width of the widest line of content.
+func (m *Model) ScrollWidth() int {
+	return text.Width(m.Content)
 }
 
-// Update function.
-func Update(msg tea.Msg, m Model) Model {
+// GotoTop scrolls to the top of the content.
+func (m *Model) GotoTop() {
+	m.ScrollY = 0
+}
+
+// GotoBottom scrolls to the bottom of the content.
+func (m *Model) GotoBottom() {
+	m.SetYOffset(m.ScrollHeight())
+}
+
+// SetYOffset scrolls to line n, bounded by the content.
+func (m *Model) SetYOffset(n int) {
+	m.ScrollY = clamp(n, 0, m.ScrollHeight()-m.Height)
+}
+
+// Selection returns the first and last selected lines, or false when not selecting.
+func (m *Model) Selection() (from, to int, ok bool) {
+	if !m.selecting {
+		return 0, 0, false
+	}
+	return min(m.anchor, m.cursor), max(m.anchor, m.cursor), true
+}
+
+// Update function. Up and Down scroll by ScrollBy lines, PgUp and PgDn
+// by a page, Ctrl-U and Ctrl-D by half a page, and Home and End to the
+// top and bottom. Left and Right scroll by ScrollBy columns when Width
+// is set.
+//
+// The v key starts selecting lines from the top of the viewport, the
+// vertical keys extend the selection, and Escape or v ends it. The y key
+// copies the selected lines to the clipboard and ends the selection.
+func Update(msg tea.Msg, m Model) (Model, tea.Cmd) {
+	by := m.ScrollBy
+	if by <= 0 {
+		by = 1
+	}
+
+	half := max(1, m.Height/2)
+
 	switch msg := msg.(type) {
-	case *terminput.KeyboardInput:
-		switch msg.Key() {
-		case terminput.KeyUp:
-			m.ScrollY = max(0, m.ScrollY-m.ScrollBy)
-			return m
-		case terminput.KeyDown:
-			m.ScrollY = min(m.ScrollY+m.ScrollBy, m.ScrollHeight-m.Height)
-			return m
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, "up"):
+			return scroll(m, -by), nil
+		case key.Matches(msg, "down"):
+			return scroll(m, by), nil
+		case key.Matches(msg, "pgup"):
+			return scroll(m, -max(1, m.Height)), nil
+		case key.Matches(msg, "pgdown"):
+			return scroll(m, max(1, m.Height)), nil
+		case key.Matches(msg, "ctrl+u"):
+			return scroll(m, -half), nil
+		case key.Matches(msg, "ctrl+d"):
+			return scroll(m, half), nil
+		case key.Matches(msg, "home"):
+			return scroll(m, -m.ScrollHeight()), nil
+		case key.Matches(msg, "end"):
+			return scroll(m, m.ScrollHeight()), nil
+		case key.Matches(msg, "left") && m.Width > 0:
+			m.ScrollX = clamp(m.ScrollX-by, 0, m.ScrollWidth()-m.Width)
+			return m, nil
+		case key.Matches(msg, "right") && m.Width > 0:
+			m.ScrollX = clamp(m.ScrollX+by, 0, m.ScrollWidth()-m.Width)
+			return m, nil
+		case key.Matches(msg, "esc"):
+			m.selecting = false
+			return m, nil
+		case key.Matches(msg, "y") && m.selecting:
+			cmd := Copy(m)
+			m.selecting = false
+			return m, cmd
+		case key.Matches(msg, "v"):
+			m.selecting = !m.selecting
+			m.anchor = m.ScrollY
+			m.cursor = m.ScrollY
+			return m, nil
 		}
 	}
-	return m
+	return m, nil
 }
 
 // View function.
-func View(m Model, content string) string {
-	lines := strings.Split(content, "\n")
+func View(m Model) string {
+	lines := lines(m.Content)
+
+	if from, to, ok := m.Selection(); ok {
+		for i := from; i <= to && i < len(lines); i++ {
+			lines[i] = cursor.Render(lines[i])
+		}
+	}
+
 	from := m.ScrollY
 	to := m.ScrollY + m.Height
 	lines = bounded(lines, from, to)
+
+	if m.Width > 0 {
+		for i, line := range lines {
+			lines[i] = text.Cut(line, m.ScrollX, m.ScrollX+m.Width)
+		}
+	}
+
 	return strings.Join(lines, "\n")
 }
 
+// Copy returns a command which copies the selected lines of
+// content to the clipboard, or nil when not selecting.
+func Copy(m Model) tea.Cmd {
+	from, to, ok := m.Selection()
+	if !ok {
+		return nil
+	}
+	lines := bounded(lines(text.Strip(m.Content)), from, to+1)
+	return tea.SetClipboard(strings.Join(lines, "\n"))
+}
+
+// scroll by n lines, or moves the end of the selection
+// by n lines and scrolls it into view when selecting.
+func scroll(m Model, n int) Model {
+	if m.selecting {
+		m.cursor = clamp(m.cursor+n, 0, m.ScrollHeight()-1)
+		m.ScrollY = min(m.ScrollY, m.cursor)
+		m.ScrollY = max(m.ScrollY, m.cursor-m.Height+1)
+		return m
+	}
+
+	m.SetYOffset(m.ScrollY + n)
+	return m
+}
+
+// lines returns the lines of content.
+func lines(content string) []string {
+	return strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+}
+
 // bounded slice.
 func bounded(s []string, from, to int) []string {
 	from = max(0, min(from, len(s)))
@@ -56,6 +194,17 @@ func bounded(s []string, from, to int) []string {
 	return s[from:to]
 }
 
+// clamp n between min and max, preferring min when max is less than min.
+func clamp(n, min, max int) int {
+	if n > max {
+		n = max
+	}
+	if n < min {
+		n = min
+	}
+	return n
+}
+
 // min returns the minimum of two ints.
 func min(a, b int) int {
 	if a < b {
@@ -71,8 +220,3 @@ func max(a, b int) int {
 	}
 	return b
 }
-
-// bell sound.
-func bell() {
-	fmt.Printf("\a")
-}